@@ -0,0 +1,207 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterTimeWindowScalerSpec defines the desired state of ClusterTimeWindowScaler
+type ClusterTimeWindowScalerSpec struct {
+	// Selector picks the workloads to scale; namespaceSelector is required
+	// and an empty namespaceSelector selects every namespace
+	// +kubebuilder:validation:XValidation:rule="has(self.namespaceSelector)",message="namespaceSelector is required"
+	Selector TargetSelector `json:"selector"`
+
+	// DefaultReplicas is the replica count when no windows match
+	// (minReplicas when targeting a HorizontalPodAutoscaler)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	DefaultReplicas int32 `json:"defaultReplicas"`
+
+	// DefaultMaxReplicas is the HorizontalPodAutoscaler maxReplicas when no windows match;
+	// when unset the HPA's existing maxReplicas is kept
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DefaultMaxReplicas *int32 `json:"defaultMaxReplicas,omitempty"`
+
+	// Timezone for evaluating time windows (IANA timezone)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[A-Za-z]+(/[A-Za-z_]+)?(/[A-Za-z_]+)?$`
+	// +kubebuilder:example="America/New_York"
+	Timezone string `json:"timezone"`
+
+	// DSTPolicy determines what happens to window starts that fall into a daylight saving gap:
+	// ShiftForward starts the window at the end of the gap, Skip skips that occurrence
+	// +kubebuilder:validation:Enum=ShiftForward;Skip
+	// +kubebuilder:default="ShiftForward"
+	// +optional
+	DSTPolicy string `json:"dstPolicy,omitempty"`
+
+	// Windows define time-based scaling rules
+	// +optional
+	Windows []TimeWindow `json:"windows,omitempty"`
+
+	// ConflictResolution determines which window applies when several windows are active at once;
+	// ties are broken in favour of the window listed last
+	// +kubebuilder:validation:Enum=LastWins;HighestPriority;MaxReplicas;MinReplicas
+	// +kubebuilder:default="LastWins"
+	// +optional
+	ConflictResolution string `json:"conflictResolution,omitempty"`
+
+	// LeadTime starts the scale-up of every window this much before it starts, e.g. "4m";
+	// scale-downs are never pulled forward
+	// +optional
+	LeadTime *metav1.Duration `json:"leadTime,omitempty"`
+
+	// GracePeriodSeconds for scale-down operations
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +kubebuilder:default=300
+	// +optional
+	GracePeriodSeconds *int32 `json:"gracePeriodSeconds,omitempty"`
+
+	// Pause disables all scaling operations
+	// +kubebuilder:default=false
+	// +optional
+	Pause bool `json:"pause,omitempty"`
+
+	// DeletionPolicy determines what happens to the selected workloads when the ClusterTimeWindowScaler is deleted
+	// +kubebuilder:validation:Enum=Retain;RestoreOriginal;SetDefault
+	// +kubebuilder:default="Retain"
+	// +optional
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// ClusterTimeWindowScalerStatus defines the observed state of ClusterTimeWindowScaler.
+type ClusterTimeWindowScalerStatus struct {
+	// ObservedGeneration tracks the generation of the spec
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// EffectiveReplicas is the computed desired replica count
+	// +optional
+	EffectiveReplicas *int32 `json:"effectiveReplicas,omitempty"`
+
+	// EffectiveMaxReplicas is the computed maxReplicas for HorizontalPodAutoscaler targets
+	// +optional
+	EffectiveMaxReplicas *int32 `json:"effectiveMaxReplicas,omitempty"`
+
+	// Targets lists the observed and effective replicas of each scaled workload
+	// +optional
+	// +listType=map
+	// +listMapKey=namespace
+	// +listMapKey=name
+	Targets []ClusterTargetStatus `json:"targets,omitempty"`
+
+	// CurrentWindow indicates the active time window
+	// +optional
+	CurrentWindow string `json:"currentWindow,omitempty"`
+
+	// MatchedWindows lists every active window in spec order; CurrentWindow is the one chosen
+	// by the conflict resolution
+	// +optional
+	MatchedWindows []string `json:"matchedWindows,omitempty"`
+
+	// NextBoundary is the next time a scaling action might occur
+	// +optional
+	NextBoundary *metav1.Time `json:"nextBoundary,omitempty"`
+
+	// Upcoming lists the next scheduled transitions, without grace periods or manual changes
+	// +optional
+	Upcoming []UpcomingTransition `json:"upcoming,omitempty"`
+
+	// LastScaleTime is when the last scaling action occurred
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// Conditions represent the latest observations of the resource state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ClusterTargetStatus reports the state of one workload scaled by a ClusterTimeWindowScaler
+type ClusterTargetStatus struct {
+	// Kind of the workload
+	Kind string `json:"kind"`
+
+	// Name of the workload
+	Name string `json:"name"`
+
+	// Namespace of the workload
+	Namespace string `json:"namespace"`
+
+	// ObservedReplicas is the replica count last observed on the workload
+	// +optional
+	ObservedReplicas *int32 `json:"observedReplicas,omitempty"`
+
+	// EffectiveReplicas is the computed desired replica count for the workload
+	// +optional
+	EffectiveReplicas *int32 `json:"effectiveReplicas,omitempty"`
+
+	// OriginalReplicas is the replica count observed before the workload was first managed
+	// +optional
+	OriginalReplicas *int32 `json:"originalReplicas,omitempty"`
+
+	// OriginalMaxReplicas is the HorizontalPodAutoscaler maxReplicas observed before it was first managed
+	// +optional
+	OriginalMaxReplicas *int32 `json:"originalMaxReplicas,omitempty"`
+
+	// Message describes the last error scaling this workload, if any
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=ctws
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.selector.kind",priority=1
+// +kubebuilder:printcolumn:name="Default",type="integer",JSONPath=".spec.defaultReplicas"
+// +kubebuilder:printcolumn:name="Effective",type="integer",JSONPath=".status.effectiveReplicas"
+// +kubebuilder:printcolumn:name="Window",type="string",JSONPath=".status.currentWindow"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ClusterTimeWindowScaler is the Schema for the clustertimewindowscalers API
+type ClusterTimeWindowScaler struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of ClusterTimeWindowScaler
+	// +required
+	Spec ClusterTimeWindowScalerSpec `json:"spec"`
+
+	// status defines the observed state of ClusterTimeWindowScaler
+	// +optional
+	Status ClusterTimeWindowScalerStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterTimeWindowScalerList contains a list of ClusterTimeWindowScaler
+type ClusterTimeWindowScalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterTimeWindowScaler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterTimeWindowScaler{}, &ClusterTimeWindowScalerList{})
+}
@@ -24,33 +24,86 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // TimeWindowScalerSpec defines the desired state of TimeWindowScaler
+// +kubebuilder:validation:XValidation:rule="has(self.targetRef) != has(self.selector)",message="exactly one of targetRef or selector must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.scheduleRef) != has(self.timezone)",message="exactly one of scheduleRef or timezone must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.scheduleRef) || (!has(self.windows) && !has(self.holidayConfigMap))",message="windows and holidayConfigMap must not be set together with scheduleRef"
 type TimeWindowScalerSpec struct {
-	// TargetRef identifies the Deployment to scale
-	// +kubebuilder:validation:Required
-	TargetRef TargetRef `json:"targetRef"`
+	// TargetRef identifies the workload to scale; mutually exclusive with Selector
+	// +optional
+	TargetRef TargetRef `json:"targetRef,omitempty,omitzero"`
+
+	// Selector scales every workload matching a label selector; mutually exclusive with TargetRef
+	// +optional
+	Selector *TargetSelector `json:"selector,omitempty"`
 
 	// DefaultReplicas is the replica count when no windows match
+	// (minReplicas when targeting a HorizontalPodAutoscaler)
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:default=1
 	DefaultReplicas int32 `json:"defaultReplicas"`
 
-	// Timezone for evaluating time windows (IANA timezone)
-	// +kubebuilder:validation:Required
+	// DefaultMaxReplicas is the HorizontalPodAutoscaler maxReplicas when no windows match;
+	// when unset the HPA's existing maxReplicas is kept
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DefaultMaxReplicas *int32 `json:"defaultMaxReplicas,omitempty"`
+
+	// ScheduleRef references a TimeWindowSchedule in the same namespace that provides
+	// Timezone, DSTPolicy, Windows, HolidayMode and HolidayConfigMap; mutually exclusive with those fields
+	// +optional
+	ScheduleRef *ScheduleRef `json:"scheduleRef,omitempty"`
+
+	// Timezone for evaluating time windows (IANA timezone); required unless ScheduleRef is set
 	// +kubebuilder:validation:Pattern=`^[A-Za-z]+(/[A-Za-z_]+)?(/[A-Za-z_]+)?$`
 	// +kubebuilder:example="America/New_York"
-	Timezone string `json:"timezone"`
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// DSTPolicy determines what happens to window starts that fall into a daylight saving gap:
+	// ShiftForward starts the window at the end of the gap, Skip skips that occurrence
+	// +kubebuilder:validation:Enum=ShiftForward;Skip
+	// +kubebuilder:default="ShiftForward"
+	// +optional
+	DSTPolicy string `json:"dstPolicy,omitempty"`
 
 	// Windows define time-based scaling rules
 	// +optional
 	Windows []TimeWindow `json:"windows,omitempty"`
 
+	// OneOffWindows are windows between two absolute datetimes that never recur, e.g. a product launch.
+	// They are evaluated after Windows, so an active one-off window takes precedence over recurring windows.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	OneOffWindows []OneOffWindow `json:"oneOffWindows,omitempty"`
+
+	// ConflictResolution determines which window applies when several windows are active at once;
+	// ties are broken in favour of the window listed last
+	// +kubebuilder:validation:Enum=LastWins;HighestPriority;MaxReplicas;MinReplicas
+	// +kubebuilder:default="LastWins"
+	// +optional
+	ConflictResolution string `json:"conflictResolution,omitempty"`
+
+	// ExpiredWindowPolicy determines whether one-off windows are kept in the spec once they have ended
+	// +kubebuilder:validation:Enum=Retain;Delete
+	// +kubebuilder:default="Retain"
+	// +optional
+	ExpiredWindowPolicy string `json:"expiredWindowPolicy,omitempty"`
+
+	// LeadTime starts the scale-up of every window this much before it starts, e.g. "4m", so pods are ready
+	// when the window begins; the reported window start is unchanged and scale-downs are never pulled forward
+	// +optional
+	LeadTime *metav1.Duration `json:"leadTime,omitempty"`
+
 	// HolidayMode determines how holidays affect scaling
 	// +kubebuilder:validation:Enum=ignore;treat-as-closed;treat-as-open
 	// +kubebuilder:default="ignore"
 	// +optional
 	HolidayMode string `json:"holidayMode,omitempty"`
 
-	// HolidayConfigMap references a ConfigMap with holiday dates
+	// HolidayConfigMap references a ConfigMap whose keys are holiday dates (YYYY-MM-DD), annual
+	// dates (MM-DD), date ranges (YYYY-MM-DD..YYYY-MM-DD) or weekday rules (11-4th-thu), named by their values.
+	// Keys ending in .ics hold an iCalendar calendar whose events are holidays.
 	// +optional
 	HolidayConfigMap *string `json:"holidayConfigMap,omitempty"`
 
@@ -61,50 +114,348 @@ type TimeWindowScalerSpec struct {
 	// +optional
 	GracePeriodSeconds *int32 `json:"gracePeriodSeconds,omitempty"`
 
+	// Override temporarily pins the targets to a replica count; the schedule resumes once it expires
+	// +optional
+	Override *ReplicaOverride `json:"override,omitempty"`
+
 	// Pause disables all scaling operations
 	// +kubebuilder:default=false
 	// +optional
 	Pause bool `json:"pause,omitempty"`
+
+	// DriftPolicy determines how manual changes to the targets' replicas are handled
+	// +kubebuilder:validation:Enum=Revert;AllowUntilNextBoundary;AllowUpOnly;Ignore
+	// +kubebuilder:default="Revert"
+	// +optional
+	DriftPolicy string `json:"driftPolicy,omitempty"`
+
+	// DeletionPolicy determines what happens to the targets when the TimeWindowScaler is deleted
+	// +kubebuilder:validation:Enum=Retain;RestoreOriginal;SetDefault
+	// +kubebuilder:default="Retain"
+	// +optional
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// ReplicaOverride pins the replica count until a point in time
+type ReplicaOverride struct {
+	// Replicas to maintain while the override is active
+	// (minReplicas when targeting a HorizontalPodAutoscaler)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Required
+	Replicas int32 `json:"replicas"`
+
+	// Until is when the override expires
+	// +kubebuilder:validation:Required
+	Until metav1.Time `json:"until"`
 }
 
+// OneOffWindow is a time window between two absolute datetimes
+// +kubebuilder:validation:XValidation:rule="self.start < self.end",message="end must be after start"
+// +kubebuilder:validation:XValidation:rule="!has(self.stepSize) || has(self.rampDuration)",message="stepSize requires rampDuration"
+type OneOffWindow struct {
+	// Name of this window, reported in status once it has expired
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Start datetime in YYYY-MM-DDTHH:MM format (24-hour), in the schedule's timezone
+	// +kubebuilder:validation:Pattern=`^[0-9]{4}-[0-9]{2}-[0-9]{2}T([0-1][0-9]|2[0-3]):[0-5][0-9]$`
+	// +kubebuilder:example="2026-11-20T08:00"
+	// +kubebuilder:validation:Required
+	Start string `json:"start"`
+
+	// End datetime in YYYY-MM-DDTHH:MM format (24-hour), in the schedule's timezone
+	// +kubebuilder:validation:Pattern=`^[0-9]{4}-[0-9]{2}-[0-9]{2}T([0-1][0-9]|2[0-3]):[0-5][0-9]$`
+	// +kubebuilder:validation:Required
+	End string `json:"end"`
+
+	// Replicas to maintain during this window
+	// (minReplicas when targeting a HorizontalPodAutoscaler)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Required
+	Replicas int32 `json:"replicas"`
+
+	// MaxReplicas is the HorizontalPodAutoscaler maxReplicas during this window;
+	// falls back to DefaultMaxReplicas when unset
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// Priority of this window under the HighestPriority conflict resolution; higher wins
+	// +kubebuilder:default=0
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// RampDuration spreads the change of replicas at each start and end of this window over
+	// this period after the boundary, e.g. "30m"; replaces the grace period for these changes
+	// +optional
+	RampDuration *metav1.Duration `json:"rampDuration,omitempty"`
+
+	// StepSize is the number of replicas added or removed per ramp step; defaults to 1 (linear)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	StepSize *int32 `json:"stepSize,omitempty"`
+
+	// LeadTime starts the scale-up of this window this much before it starts, e.g. "4m";
+	// overrides spec.leadTime, and "0s" disables it
+	// +optional
+	LeadTime *metav1.Duration `json:"leadTime,omitempty"`
+}
+
+// DST policies
+const (
+	// DSTPolicyShiftForward starts a window whose start falls into a DST gap at the end of the gap
+	DSTPolicyShiftForward = "ShiftForward"
+	// DSTPolicySkip skips the occurrence of a window whose start falls into a DST gap
+	DSTPolicySkip = "Skip"
+)
+
+// Conflict resolution strategies
+const (
+	// ConflictResolutionLastWins applies the active window listed last
+	ConflictResolutionLastWins = "LastWins"
+	// ConflictResolutionHighestPriority applies the active window with the highest priority
+	ConflictResolutionHighestPriority = "HighestPriority"
+	// ConflictResolutionMaxReplicas applies the active window with the most replicas
+	ConflictResolutionMaxReplicas = "MaxReplicas"
+	// ConflictResolutionMinReplicas applies the active window with the fewest replicas
+	ConflictResolutionMinReplicas = "MinReplicas"
+)
+
+// Expired window policies
+const (
+	// ExpiredWindowPolicyRetain keeps ended one-off windows in the spec
+	ExpiredWindowPolicyRetain = "Retain"
+	// ExpiredWindowPolicyDelete removes ended one-off windows from the spec
+	ExpiredWindowPolicyDelete = "Delete"
+)
+
+// Deletion policies
+const (
+	// DeletionPolicyRetain leaves targets at their last scaled replicas
+	DeletionPolicyRetain = "Retain"
+	// DeletionPolicyRestoreOriginal restores the replicas observed before the target was first managed
+	DeletionPolicyRestoreOriginal = "RestoreOriginal"
+	// DeletionPolicySetDefault scales targets to DefaultReplicas
+	DeletionPolicySetDefault = "SetDefault"
+)
+
+// Drift policies
+const (
+	// DriftPolicyRevert reverts manual changes at the next reconcile
+	DriftPolicyRevert = "Revert"
+	// DriftPolicyAllowUntilNextBoundary keeps manual changes until the next schedule boundary
+	DriftPolicyAllowUntilNextBoundary = "AllowUntilNextBoundary"
+	// DriftPolicyAllowUpOnly keeps manual scale-ups until the next boundary and reverts scale-downs
+	DriftPolicyAllowUpOnly = "AllowUpOnly"
+	// DriftPolicyIgnore keeps manual changes until the computed replicas change
+	DriftPolicyIgnore = "Ignore"
+)
+
+// Annotations recording the replicas of a target before it was first managed
+const (
+	OriginalReplicasAnnotation    = "kyklos.kyklos.io/original-replicas"
+	OriginalMaxReplicasAnnotation = "kyklos.kyklos.io/original-max-replicas"
+)
+
 // TargetRef identifies the target workload
 type TargetRef struct {
-	// Name of the Deployment
+	// APIVersion of the target workload
+	// +kubebuilder:default="apps/v1"
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind of the target workload; any kind exposing the scale subresource is supported
+	// +kubebuilder:default="Deployment"
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name of the target workload
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
 
-	// Namespace of the Deployment (defaults to TWS namespace)
+	// Namespace of the target workload (defaults to TWS namespace)
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// TargetSelector selects a set of target workloads by label.
+// Only the kinds the controller lists and watches can be selected; use targetRef for other scalable kinds.
+// +kubebuilder:validation:XValidation:rule="!has(self.apiVersion) || self.apiVersion == ((has(self.kind) && self.kind == 'HorizontalPodAutoscaler') ? 'autoscaling/v2' : 'apps/v1')",message="apiVersion must be apps/v1 for Deployments and StatefulSets, autoscaling/v2 for HorizontalPodAutoscalers"
+type TargetSelector struct {
+	// APIVersion of the selected workloads
+	// +kubebuilder:default="apps/v1"
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind of the selected workloads
+	// +kubebuilder:validation:Enum=Deployment;StatefulSet;HorizontalPodAutoscaler
+	// +kubebuilder:default="Deployment"
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// LabelSelector matches workload labels; an empty selector matches every workload of the kind
+	metav1.LabelSelector `json:",inline"`
+
+	// NamespaceSelector selects the namespaces to search (defaults to the TWS namespace only)
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// ScheduleRef identifies a TimeWindowSchedule
+type ScheduleRef struct {
+	// Name of the TimeWindowSchedule
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+// Well-known target workload kinds
+const (
+	TargetKindDeployment              = "Deployment"
+	TargetKindStatefulSet             = "StatefulSet"
+	TargetKindHorizontalPodAutoscaler = "HorizontalPodAutoscaler"
+)
+
+// OptOutAnnotation excludes a namespace or workload from selector-based scaling when set to "true"
+const OptOutAnnotation = "kyklos.kyklos.io/opt-out"
+
 // TimeWindow defines a time-based scaling rule
+// +kubebuilder:validation:XValidation:rule="has(self.startCron) ? (has(self.duration) && !has(self.start) && !has(self.end) && !has(self.days)) : (has(self.start) && has(self.end) && !has(self.duration))",message="either start and end, or startCron and duration must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.startCron) || (!has(self.months) && !has(self.startDate) && !has(self.endDate) && !has(self.daysOfMonth) && !has(self.businessDaysOfMonth) && !has(self.weekdayOccurrence) && !has(self.startDay))",message="months, startDate, endDate, daysOfMonth, businessDaysOfMonth, weekdayOccurrence and startDay cannot be combined with startCron"
+// +kubebuilder:validation:XValidation:rule="has(self.startDay) == has(self.endDay)",message="startDay and endDay must be set together"
+// +kubebuilder:validation:XValidation:rule="!has(self.startDay) || !has(self.days)",message="days cannot be combined with startDay"
+// +kubebuilder:validation:XValidation:rule="!has(self.startDate) || !has(self.endDate) || self.startDate <= self.endDate",message="endDate must not be before startDate"
+// +kubebuilder:validation:XValidation:rule="!has(self.stepSize) || has(self.rampDuration)",message="stepSize requires rampDuration"
 type TimeWindow struct {
-	// Start time in HH:MM format (24-hour)
-	// +kubebuilder:validation:Required
+	// Start time in HH:MM format (24-hour); required unless StartCron is set
 	// +kubebuilder:validation:Pattern=`^([0-1]?[0-9]|2[0-3]):[0-5][0-9]$`
-	Start string `json:"start"`
+	// +optional
+	Start string `json:"start,omitempty"`
 
-	// End time in HH:MM format (24-hour)
-	// +kubebuilder:validation:Required
+	// End time in HH:MM format (24-hour); required unless StartCron is set
 	// +kubebuilder:validation:Pattern=`^([0-1]?[0-9]|2[0-3]):[0-5][0-9]$`
-	End string `json:"end"`
+	// +optional
+	End string `json:"end,omitempty"`
+
+	// StartCron is a five-field cron expression (minute hour day-of-month month day-of-week)
+	// giving the window start times in the schedule's timezone; used instead of Start, End and Days.
+	// When both day fields are restricted a date must match both.
+	// +kubebuilder:example="0 2 1-7 * MON"
+	// +optional
+	StartCron string `json:"startCron,omitempty"`
+
+	// Duration of each window started by StartCron, e.g. "4h"
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
 
 	// Replicas to maintain during this window
+	// (minReplicas when targeting a HorizontalPodAutoscaler)
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Required
 	Replicas int32 `json:"replicas"`
 
-	// Days when this window is active
+	// MaxReplicas is the HorizontalPodAutoscaler maxReplicas during this window;
+	// falls back to DefaultMaxReplicas when unset
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// Days when this window is active: full or three-letter day names, ranges such as "Mon-Fri",
+	// or "weekdays" and "weekends"; expanded into full day names on admission
 	// +optional
 	Days []string `json:"days,omitempty"`
 
+	// StartDay is the day a window spanning several days starts on, at Start; used instead of Days
+	// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+	// +optional
+	StartDay string `json:"startDay,omitempty"`
+
+	// EndDay is the day a window spanning several days ends on, at End; the window ends on the first
+	// EndDay after it starts, a week later when EndDay equals StartDay and End is not after Start
+	// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+	// +optional
+	EndDay string `json:"endDay,omitempty"`
+
+	// Months when this window is active, as full month names (e.g. "December")
+	// +optional
+	Months []string `json:"months,omitempty"`
+
+	// StartDate is the first date (YYYY-MM-DD, inclusive) the window may start on,
+	// in the schedule's timezone
+	// +kubebuilder:validation:Pattern=`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`
+	// +optional
+	StartDate string `json:"startDate,omitempty"`
+
+	// EndDate is the last date (YYYY-MM-DD, inclusive) the window may start on,
+	// in the schedule's timezone; an occurrence crossing midnight still runs to its end
+	// +kubebuilder:validation:Pattern=`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`
+	// +optional
+	EndDate string `json:"endDate,omitempty"`
+
+	// DaysOfMonth when this window is active; negative days count back from the end of the month,
+	// so -1 is the last day. Days beyond the length of a month, such as 31 in April, never match.
+	// +kubebuilder:validation:MaxItems=31
+	// +kubebuilder:validation:items:Minimum=-31
+	// +kubebuilder:validation:items:Maximum=31
+	// +kubebuilder:validation:XValidation:rule="self.all(d, d != 0)",message="daysOfMonth must not contain 0"
+	// +optional
+	DaysOfMonth []int32 `json:"daysOfMonth,omitempty"`
+
+	// BusinessDaysOfMonth when this window is active, counting only Monday to Friday and never matching
+	// weekends; negative days count back from the end of the month, so -1 is the last business day.
+	// Holidays are not skipped.
+	// +kubebuilder:validation:MaxItems=23
+	// +kubebuilder:validation:items:Minimum=-23
+	// +kubebuilder:validation:items:Maximum=23
+	// +kubebuilder:validation:XValidation:rule="self.all(d, d != 0)",message="businessDaysOfMonth must not contain 0"
+	// +optional
+	BusinessDaysOfMonth []int32 `json:"businessDaysOfMonth,omitempty"`
+
+	// WeekdayOccurrence restricts this window to the nth occurrence of a weekday in the month
+	// +optional
+	WeekdayOccurrence *WeekdayOccurrence `json:"weekdayOccurrence,omitempty"`
+
+	// Priority of this window under the HighestPriority conflict resolution; higher wins
+	// +kubebuilder:default=0
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// RampDuration spreads the change of replicas at each start and end of this window over
+	// this period after the boundary, e.g. "30m"; replaces the grace period for these changes
+	// +optional
+	RampDuration *metav1.Duration `json:"rampDuration,omitempty"`
+
+	// StepSize is the number of replicas added or removed per ramp step; defaults to 1 (linear)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	StepSize *int32 `json:"stepSize,omitempty"`
+
+	// LeadTime starts the scale-up of this window this much before it starts, e.g. "4m";
+	// overrides spec.leadTime, and "0s" disables it
+	// +optional
+	LeadTime *metav1.Duration `json:"leadTime,omitempty"`
+
 	// Name for this window (used in labels)
 	// +optional
 	// +kubebuilder:validation:MaxLength=63
 	Name string `json:"name,omitempty"`
 }
 
+// WeekdayOccurrence selects the nth occurrence of a weekday in a month, e.g. the last Friday
+type WeekdayOccurrence struct {
+	// Weekday as a full day name
+	// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+	// +kubebuilder:validation:Required
+	Weekday string `json:"weekday"`
+
+	// Nth occurrence of the weekday: 1 to 5 count from the start of the month, -1 to -5 from the end
+	// +kubebuilder:validation:Minimum=-5
+	// +kubebuilder:validation:Maximum=5
+	// +kubebuilder:validation:XValidation:rule="self != 0",message="nth must not be 0"
+	// +kubebuilder:validation:Required
+	Nth int32 `json:"nth"`
+}
+
 // TimeWindowScalerStatus defines the observed state of TimeWindowScaler.
 type TimeWindowScalerStatus struct {
 	// ObservedGeneration tracks the generation of the spec
@@ -115,18 +466,38 @@ type TimeWindowScalerStatus struct {
 	// +optional
 	EffectiveReplicas *int32 `json:"effectiveReplicas,omitempty"`
 
+	// EffectiveMaxReplicas is the computed maxReplicas for HorizontalPodAutoscaler targets
+	// +optional
+	EffectiveMaxReplicas *int32 `json:"effectiveMaxReplicas,omitempty"`
+
 	// TargetObservedReplicas is the observed replica count on the target
 	// +optional
 	TargetObservedReplicas *int32 `json:"targetObservedReplicas,omitempty"`
 
+	// Targets lists the observed and effective replicas of each scaled workload
+	// +optional
+	// +listType=map
+	// +listMapKey=namespace
+	// +listMapKey=name
+	Targets []TargetStatus `json:"targets,omitempty"`
+
 	// CurrentWindow indicates the active time window
 	// +optional
 	CurrentWindow string `json:"currentWindow,omitempty"`
 
+	// MatchedWindows lists every active window in spec order; CurrentWindow is the one chosen
+	// by the conflict resolution
+	// +optional
+	MatchedWindows []string `json:"matchedWindows,omitempty"`
+
 	// NextBoundary is the next time a scaling action might occur
 	// +optional
 	NextBoundary *metav1.Time `json:"nextBoundary,omitempty"`
 
+	// Upcoming lists the next scheduled transitions, without grace periods or manual changes
+	// +optional
+	Upcoming []UpcomingTransition `json:"upcoming,omitempty"`
+
 	// LastScaleTime is when the last scaling action occurred
 	// +optional
 	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
@@ -135,14 +506,79 @@ type TimeWindowScalerStatus struct {
 	// +optional
 	GracePeriodExpiry *metav1.Time `json:"gracePeriodExpiry,omitempty"`
 
+	// ExpiredWindows lists the one-off windows that have ended
+	// +optional
+	ExpiredWindows []string `json:"expiredWindows,omitempty"`
+
+	// Holiday is the name of today's holiday in the holiday ConfigMap, when today is one
+	// +optional
+	Holiday string `json:"holiday,omitempty"`
+
 	// Conditions represent the latest observations of the resource state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// UpcomingTransition is a future change of the scheduled replicas
+type UpcomingTransition struct {
+	// Time of the transition
+	Time metav1.Time `json:"time"`
+
+	// Window that applies from this time, e.g. "Default" when none matches
+	Window string `json:"window"`
+
+	// Replicas scheduled from this time (minReplicas for HorizontalPodAutoscalers)
+	Replicas int32 `json:"replicas"`
+
+	// MaxReplicas is the HorizontalPodAutoscaler maxReplicas scheduled from this time
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// Reason for the replicas, e.g. "in-window", "ramping" or "holiday-closed"
+	Reason string `json:"reason"`
+}
+
+// TargetStatus reports the state of one scaled workload
+type TargetStatus struct {
+	// Kind of the workload
+	Kind string `json:"kind"`
+
+	// Name of the workload
+	Name string `json:"name"`
+
+	// Namespace of the workload
+	Namespace string `json:"namespace"`
+
+	// ObservedReplicas is the replica count last observed on the workload
+	// +optional
+	ObservedReplicas *int32 `json:"observedReplicas,omitempty"`
+
+	// EffectiveReplicas is the computed desired replica count for the workload
+	// +optional
+	EffectiveReplicas *int32 `json:"effectiveReplicas,omitempty"`
+
+	// OriginalReplicas is the replica count observed before the workload was first managed
+	// +optional
+	OriginalReplicas *int32 `json:"originalReplicas,omitempty"`
+
+	// OriginalMaxReplicas is the HorizontalPodAutoscaler maxReplicas observed before it was first managed
+	// +optional
+	OriginalMaxReplicas *int32 `json:"originalMaxReplicas,omitempty"`
+
+	// DriftAcceptedUntil is when a manual change kept by the drift policy is reverted
+	// +optional
+	DriftAcceptedUntil *metav1.Time `json:"driftAcceptedUntil,omitempty"`
+
+	// Message describes the last error scaling this workload, if any
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:resource:shortName=tws
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.targetRef.kind",priority=1
 // +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.targetRef.name"
 // +kubebuilder:printcolumn:name="Default",type="integer",JSONPath=".spec.defaultReplicas"
 // +kubebuilder:printcolumn:name="Effective",type="integer",JSONPath=".status.effectiveReplicas"
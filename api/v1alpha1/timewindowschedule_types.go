@@ -0,0 +1,84 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TimeWindowScheduleSpec defines a schedule shared by TimeWindowScalers
+type TimeWindowScheduleSpec struct {
+	// Timezone for evaluating time windows (IANA timezone)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[A-Za-z]+(/[A-Za-z_]+)?(/[A-Za-z_]+)?$`
+	// +kubebuilder:example="America/New_York"
+	Timezone string `json:"timezone"`
+
+	// DSTPolicy determines what happens to window starts that fall into a daylight saving gap:
+	// ShiftForward starts the window at the end of the gap, Skip skips that occurrence
+	// +kubebuilder:validation:Enum=ShiftForward;Skip
+	// +kubebuilder:default="ShiftForward"
+	// +optional
+	DSTPolicy string `json:"dstPolicy,omitempty"`
+
+	// Windows define time-based scaling rules
+	// +optional
+	Windows []TimeWindow `json:"windows,omitempty"`
+
+	// HolidayMode determines how holidays affect scaling
+	// +kubebuilder:validation:Enum=ignore;treat-as-closed;treat-as-open
+	// +kubebuilder:default="ignore"
+	// +optional
+	HolidayMode string `json:"holidayMode,omitempty"`
+
+	// HolidayConfigMap references a ConfigMap with holidays in the schedule's namespace, in the format
+	// described on TimeWindowScalerSpec.HolidayConfigMap
+	// +optional
+	HolidayConfigMap *string `json:"holidayConfigMap,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=twsched
+// +kubebuilder:printcolumn:name="Timezone",type="string",JSONPath=".spec.timezone"
+// +kubebuilder:printcolumn:name="Holidays",type="string",JSONPath=".spec.holidayMode"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// TimeWindowSchedule is the Schema for the timewindowschedules API
+type TimeWindowSchedule struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the shared schedule
+	// +required
+	Spec TimeWindowScheduleSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// TimeWindowScheduleList contains a list of TimeWindowSchedule
+type TimeWindowScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TimeWindowSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TimeWindowSchedule{}, &TimeWindowScheduleList{})
+}
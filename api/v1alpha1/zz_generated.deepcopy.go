@@ -25,6 +25,263 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTargetStatus) DeepCopyInto(out *ClusterTargetStatus) {
+	*out = *in
+	if in.ObservedReplicas != nil {
+		in, out := &in.ObservedReplicas, &out.ObservedReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EffectiveReplicas != nil {
+		in, out := &in.EffectiveReplicas, &out.EffectiveReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OriginalReplicas != nil {
+		in, out := &in.OriginalReplicas, &out.OriginalReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OriginalMaxReplicas != nil {
+		in, out := &in.OriginalMaxReplicas, &out.OriginalMaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTargetStatus.
+func (in *ClusterTargetStatus) DeepCopy() *ClusterTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTimeWindowScaler) DeepCopyInto(out *ClusterTimeWindowScaler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTimeWindowScaler.
+func (in *ClusterTimeWindowScaler) DeepCopy() *ClusterTimeWindowScaler {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTimeWindowScaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTimeWindowScaler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTimeWindowScalerList) DeepCopyInto(out *ClusterTimeWindowScalerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterTimeWindowScaler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTimeWindowScalerList.
+func (in *ClusterTimeWindowScalerList) DeepCopy() *ClusterTimeWindowScalerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTimeWindowScalerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTimeWindowScalerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTimeWindowScalerSpec) DeepCopyInto(out *ClusterTimeWindowScalerSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.DefaultMaxReplicas != nil {
+		in, out := &in.DefaultMaxReplicas, &out.DefaultMaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]TimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LeadTime != nil {
+		in, out := &in.LeadTime, &out.LeadTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTimeWindowScalerSpec.
+func (in *ClusterTimeWindowScalerSpec) DeepCopy() *ClusterTimeWindowScalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTimeWindowScalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTimeWindowScalerStatus) DeepCopyInto(out *ClusterTimeWindowScalerStatus) {
+	*out = *in
+	if in.EffectiveReplicas != nil {
+		in, out := &in.EffectiveReplicas, &out.EffectiveReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EffectiveMaxReplicas != nil {
+		in, out := &in.EffectiveMaxReplicas, &out.EffectiveMaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]ClusterTargetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MatchedWindows != nil {
+		in, out := &in.MatchedWindows, &out.MatchedWindows
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextBoundary != nil {
+		in, out := &in.NextBoundary, &out.NextBoundary
+		*out = (*in).DeepCopy()
+	}
+	if in.Upcoming != nil {
+		in, out := &in.Upcoming, &out.Upcoming
+		*out = make([]UpcomingTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTimeWindowScalerStatus.
+func (in *ClusterTimeWindowScalerStatus) DeepCopy() *ClusterTimeWindowScalerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTimeWindowScalerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OneOffWindow) DeepCopyInto(out *OneOffWindow) {
+	*out = *in
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RampDuration != nil {
+		in, out := &in.RampDuration, &out.RampDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StepSize != nil {
+		in, out := &in.StepSize, &out.StepSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LeadTime != nil {
+		in, out := &in.LeadTime, &out.LeadTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OneOffWindow.
+func (in *OneOffWindow) DeepCopy() *OneOffWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(OneOffWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaOverride) DeepCopyInto(out *ReplicaOverride) {
+	*out = *in
+	in.Until.DeepCopyInto(&out.Until)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaOverride.
+func (in *ReplicaOverride) DeepCopy() *ReplicaOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleRef) DeepCopyInto(out *ScheduleRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleRef.
+func (in *ScheduleRef) DeepCopy() *ScheduleRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetRef) DeepCopyInto(out *TargetRef) {
 	*out = *in
@@ -40,14 +297,119 @@ func (in *TargetRef) DeepCopy() *TargetRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSelector) DeepCopyInto(out *TargetSelector) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSelector.
+func (in *TargetSelector) DeepCopy() *TargetSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetStatus) DeepCopyInto(out *TargetStatus) {
+	*out = *in
+	if in.ObservedReplicas != nil {
+		in, out := &in.ObservedReplicas, &out.ObservedReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EffectiveReplicas != nil {
+		in, out := &in.EffectiveReplicas, &out.EffectiveReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OriginalReplicas != nil {
+		in, out := &in.OriginalReplicas, &out.OriginalReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OriginalMaxReplicas != nil {
+		in, out := &in.OriginalMaxReplicas, &out.OriginalMaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DriftAcceptedUntil != nil {
+		in, out := &in.DriftAcceptedUntil, &out.DriftAcceptedUntil
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetStatus.
+func (in *TargetStatus) DeepCopy() *TargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeWindow) DeepCopyInto(out *TimeWindow) {
 	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Days != nil {
 		in, out := &in.Days, &out.Days
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Months != nil {
+		in, out := &in.Months, &out.Months
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DaysOfMonth != nil {
+		in, out := &in.DaysOfMonth, &out.DaysOfMonth
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.BusinessDaysOfMonth != nil {
+		in, out := &in.BusinessDaysOfMonth, &out.BusinessDaysOfMonth
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.WeekdayOccurrence != nil {
+		in, out := &in.WeekdayOccurrence, &out.WeekdayOccurrence
+		*out = new(WeekdayOccurrence)
+		**out = **in
+	}
+	if in.RampDuration != nil {
+		in, out := &in.RampDuration, &out.RampDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StepSize != nil {
+		in, out := &in.StepSize, &out.StepSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LeadTime != nil {
+		in, out := &in.LeadTime, &out.LeadTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindow.
@@ -123,6 +485,21 @@ func (in *TimeWindowScalerList) DeepCopyObject() runtime.Object {
 func (in *TimeWindowScalerSpec) DeepCopyInto(out *TimeWindowScalerSpec) {
 	*out = *in
 	out.TargetRef = in.TargetRef
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(TargetSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultMaxReplicas != nil {
+		in, out := &in.DefaultMaxReplicas, &out.DefaultMaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScheduleRef != nil {
+		in, out := &in.ScheduleRef, &out.ScheduleRef
+		*out = new(ScheduleRef)
+		**out = **in
+	}
 	if in.Windows != nil {
 		in, out := &in.Windows, &out.Windows
 		*out = make([]TimeWindow, len(*in))
@@ -130,6 +507,18 @@ func (in *TimeWindowScalerSpec) DeepCopyInto(out *TimeWindowScalerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OneOffWindows != nil {
+		in, out := &in.OneOffWindows, &out.OneOffWindows
+		*out = make([]OneOffWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LeadTime != nil {
+		in, out := &in.LeadTime, &out.LeadTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.HolidayConfigMap != nil {
 		in, out := &in.HolidayConfigMap, &out.HolidayConfigMap
 		*out = new(string)
@@ -140,6 +529,11 @@ func (in *TimeWindowScalerSpec) DeepCopyInto(out *TimeWindowScalerSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Override != nil {
+		in, out := &in.Override, &out.Override
+		*out = new(ReplicaOverride)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindowScalerSpec.
@@ -160,15 +554,39 @@ func (in *TimeWindowScalerStatus) DeepCopyInto(out *TimeWindowScalerStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.EffectiveMaxReplicas != nil {
+		in, out := &in.EffectiveMaxReplicas, &out.EffectiveMaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
 	if in.TargetObservedReplicas != nil {
 		in, out := &in.TargetObservedReplicas, &out.TargetObservedReplicas
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MatchedWindows != nil {
+		in, out := &in.MatchedWindows, &out.MatchedWindows
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.NextBoundary != nil {
 		in, out := &in.NextBoundary, &out.NextBoundary
 		*out = (*in).DeepCopy()
 	}
+	if in.Upcoming != nil {
+		in, out := &in.Upcoming, &out.Upcoming
+		*out = make([]UpcomingTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.LastScaleTime != nil {
 		in, out := &in.LastScaleTime, &out.LastScaleTime
 		*out = (*in).DeepCopy()
@@ -177,6 +595,11 @@ func (in *TimeWindowScalerStatus) DeepCopyInto(out *TimeWindowScalerStatus) {
 		in, out := &in.GracePeriodExpiry, &out.GracePeriodExpiry
 		*out = (*in).DeepCopy()
 	}
+	if in.ExpiredWindows != nil {
+		in, out := &in.ExpiredWindows, &out.ExpiredWindows
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -195,3 +618,124 @@ func (in *TimeWindowScalerStatus) DeepCopy() *TimeWindowScalerStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindowSchedule) DeepCopyInto(out *TimeWindowSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindowSchedule.
+func (in *TimeWindowSchedule) DeepCopy() *TimeWindowSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindowSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TimeWindowSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindowScheduleList) DeepCopyInto(out *TimeWindowScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TimeWindowSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindowScheduleList.
+func (in *TimeWindowScheduleList) DeepCopy() *TimeWindowScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindowScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TimeWindowScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindowScheduleSpec) DeepCopyInto(out *TimeWindowScheduleSpec) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]TimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HolidayConfigMap != nil {
+		in, out := &in.HolidayConfigMap, &out.HolidayConfigMap
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindowScheduleSpec.
+func (in *TimeWindowScheduleSpec) DeepCopy() *TimeWindowScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindowScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpcomingTransition) DeepCopyInto(out *UpcomingTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpcomingTransition.
+func (in *UpcomingTransition) DeepCopy() *UpcomingTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(UpcomingTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeekdayOccurrence) DeepCopyInto(out *WeekdayOccurrence) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeekdayOccurrence.
+func (in *WeekdayOccurrence) DeepCopy() *WeekdayOccurrence {
+	if in == nil {
+		return nil
+	}
+	out := new(WeekdayOccurrence)
+	in.DeepCopyInto(out)
+	return out
+}
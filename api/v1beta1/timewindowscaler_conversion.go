@@ -0,0 +1,317 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+	"github.com/roguepikachu/kyklos/internal/engine"
+)
+
+// ConvertTo converts this TimeWindowScaler (v1beta1) to the Hub version (v1alpha1).
+func (src *TimeWindowScaler) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*kyklosv1alpha1.TimeWindowScaler)
+	dst.ObjectMeta = src.ObjectMeta
+
+	spec := &src.Spec
+	dst.Spec = kyklosv1alpha1.TimeWindowScalerSpec{
+		TargetRef:           kyklosv1alpha1.TargetRef(spec.TargetRef),
+		Selector:            (*kyklosv1alpha1.TargetSelector)(spec.Selector),
+		DefaultReplicas:     spec.DefaultReplicas,
+		DefaultMaxReplicas:  spec.DefaultMaxReplicas,
+		ScheduleRef:         (*kyklosv1alpha1.ScheduleRef)(spec.Schedule.Ref),
+		Timezone:            spec.Schedule.Timezone,
+		DSTPolicy:           string(spec.Schedule.DSTPolicy),
+		HolidayMode:         string(spec.Schedule.HolidayMode),
+		HolidayConfigMap:    spec.Schedule.HolidayConfigMap,
+		ConflictResolution:  string(spec.ConflictResolution),
+		ExpiredWindowPolicy: string(spec.ExpiredWindowPolicy),
+		LeadTime:            spec.LeadTime,
+		Override:            (*kyklosv1alpha1.ReplicaOverride)(spec.Override),
+		Pause:               spec.Pause,
+		DriftPolicy:         string(spec.DriftPolicy),
+		DeletionPolicy:      string(spec.DeletionPolicy),
+	}
+	if spec.GracePeriod != nil {
+		seconds := int32(spec.GracePeriod.Duration / time.Second)
+		dst.Spec.GracePeriodSeconds = &seconds
+	}
+	if spec.Schedule.Windows != nil {
+		dst.Spec.Windows = make([]kyklosv1alpha1.TimeWindow, len(spec.Schedule.Windows))
+		for i, w := range spec.Schedule.Windows {
+			dst.Spec.Windows[i] = convertTimeWindowTo(w)
+		}
+	}
+	if spec.OneOffWindows != nil {
+		dst.Spec.OneOffWindows = make([]kyklosv1alpha1.OneOffWindow, len(spec.OneOffWindows))
+		for i, w := range spec.OneOffWindows {
+			dst.Spec.OneOffWindows[i] = kyklosv1alpha1.OneOffWindow{
+				Name:         w.Name,
+				Start:        string(w.Start),
+				End:          string(w.End),
+				Replicas:     w.Replicas,
+				MaxReplicas:  w.MaxReplicas,
+				Priority:     w.Priority,
+				RampDuration: w.RampDuration,
+				StepSize:     w.StepSize,
+				LeadTime:     w.LeadTime,
+			}
+		}
+	}
+
+	status := &src.Status
+	dst.Status = kyklosv1alpha1.TimeWindowScalerStatus{
+		ObservedGeneration:     status.ObservedGeneration,
+		EffectiveReplicas:      status.EffectiveReplicas,
+		EffectiveMaxReplicas:   status.EffectiveMaxReplicas,
+		TargetObservedReplicas: status.TargetObservedReplicas,
+		CurrentWindow:          status.CurrentWindow,
+		MatchedWindows:         status.MatchedWindows,
+		NextBoundary:           status.NextBoundary,
+		LastScaleTime:          status.LastScaleTime,
+		GracePeriodExpiry:      status.GracePeriodExpiry,
+		ExpiredWindows:         status.ExpiredWindows,
+		Holiday:                status.Holiday,
+		Conditions:             status.Conditions,
+	}
+	if status.Targets != nil {
+		dst.Status.Targets = make([]kyklosv1alpha1.TargetStatus, len(status.Targets))
+		for i, target := range status.Targets {
+			dst.Status.Targets[i] = kyklosv1alpha1.TargetStatus(target)
+		}
+	}
+	if status.Upcoming != nil {
+		dst.Status.Upcoming = make([]kyklosv1alpha1.UpcomingTransition, len(status.Upcoming))
+		for i, transition := range status.Upcoming {
+			dst.Status.Upcoming[i] = kyklosv1alpha1.UpcomingTransition(transition)
+		}
+	}
+	return nil
+}
+
+// ConvertFrom converts the Hub version (v1alpha1) to this version (v1beta1).
+// Day and month names, which the Hub accepts abbreviated and in any case, become the full names of the
+// Weekday and Month enums; names that cannot be converted are an error.
+func (dst *TimeWindowScaler) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*kyklosv1alpha1.TimeWindowScaler)
+	dst.ObjectMeta = src.ObjectMeta
+
+	spec := &src.Spec
+	dst.Spec = TimeWindowScalerSpec{
+		TargetRef:          TargetRef(spec.TargetRef),
+		Selector:           (*TargetSelector)(spec.Selector),
+		DefaultReplicas:    spec.DefaultReplicas,
+		DefaultMaxReplicas: spec.DefaultMaxReplicas,
+		Schedule: Schedule{
+			Ref:              (*ScheduleRef)(spec.ScheduleRef),
+			Timezone:         spec.Timezone,
+			DSTPolicy:        DSTPolicy(spec.DSTPolicy),
+			HolidayMode:      HolidayMode(spec.HolidayMode),
+			HolidayConfigMap: spec.HolidayConfigMap,
+		},
+		ConflictResolution:  ConflictResolution(spec.ConflictResolution),
+		ExpiredWindowPolicy: ExpiredWindowPolicy(spec.ExpiredWindowPolicy),
+		LeadTime:            spec.LeadTime,
+		Override:            (*ReplicaOverride)(spec.Override),
+		Pause:               spec.Pause,
+		DriftPolicy:         DriftPolicy(spec.DriftPolicy),
+		DeletionPolicy:      DeletionPolicy(spec.DeletionPolicy),
+	}
+	if spec.GracePeriodSeconds != nil {
+		dst.Spec.GracePeriod = &metav1.Duration{Duration: time.Duration(*spec.GracePeriodSeconds) * time.Second}
+	}
+	if spec.Windows != nil {
+		dst.Spec.Schedule.Windows = make([]TimeWindow, len(spec.Windows))
+		for i, w := range spec.Windows {
+			window, err := convertTimeWindowFrom(w)
+			if err != nil {
+				return fmt.Errorf("spec.windows[%d]: %w", i, err)
+			}
+			dst.Spec.Schedule.Windows[i] = window
+		}
+	}
+	if spec.OneOffWindows != nil {
+		dst.Spec.OneOffWindows = make([]OneOffWindow, len(spec.OneOffWindows))
+		for i, w := range spec.OneOffWindows {
+			dst.Spec.OneOffWindows[i] = OneOffWindow{
+				Name:         w.Name,
+				Start:        LocalDateTime(w.Start),
+				End:          LocalDateTime(w.End),
+				Replicas:     w.Replicas,
+				MaxReplicas:  w.MaxReplicas,
+				Priority:     w.Priority,
+				RampDuration: w.RampDuration,
+				StepSize:     w.StepSize,
+				LeadTime:     w.LeadTime,
+			}
+		}
+	}
+
+	status := &src.Status
+	dst.Status = TimeWindowScalerStatus{
+		ObservedGeneration:     status.ObservedGeneration,
+		EffectiveReplicas:      status.EffectiveReplicas,
+		EffectiveMaxReplicas:   status.EffectiveMaxReplicas,
+		TargetObservedReplicas: status.TargetObservedReplicas,
+		CurrentWindow:          status.CurrentWindow,
+		MatchedWindows:         status.MatchedWindows,
+		NextBoundary:           status.NextBoundary,
+		LastScaleTime:          status.LastScaleTime,
+		GracePeriodExpiry:      status.GracePeriodExpiry,
+		ExpiredWindows:         status.ExpiredWindows,
+		Holiday:                status.Holiday,
+		Conditions:             status.Conditions,
+	}
+	if status.Targets != nil {
+		dst.Status.Targets = make([]TargetStatus, len(status.Targets))
+		for i, target := range status.Targets {
+			dst.Status.Targets[i] = TargetStatus(target)
+		}
+	}
+	if status.Upcoming != nil {
+		dst.Status.Upcoming = make([]UpcomingTransition, len(status.Upcoming))
+		for i, transition := range status.Upcoming {
+			dst.Status.Upcoming[i] = UpcomingTransition(transition)
+		}
+	}
+	return nil
+}
+
+// convertTimeWindowTo converts a window to the Hub version
+func convertTimeWindowTo(w TimeWindow) kyklosv1alpha1.TimeWindow {
+	window := kyklosv1alpha1.TimeWindow{
+		Start:               string(w.Start),
+		End:                 string(w.End),
+		StartCron:           w.StartCron,
+		Duration:            w.Duration,
+		Replicas:            w.Replicas,
+		MaxReplicas:         w.MaxReplicas,
+		Days:                convertStrings[Weekday, string](w.Days),
+		StartDay:            string(w.StartDay),
+		EndDay:              string(w.EndDay),
+		Months:              convertStrings[Month, string](w.Months),
+		StartDate:           string(w.StartDate),
+		EndDate:             string(w.EndDate),
+		DaysOfMonth:         w.DaysOfMonth,
+		BusinessDaysOfMonth: w.BusinessDaysOfMonth,
+		Priority:            w.Priority,
+		RampDuration:        w.RampDuration,
+		StepSize:            w.StepSize,
+		LeadTime:            w.LeadTime,
+		Name:                w.Name,
+	}
+	if occurrence := w.WeekdayOccurrence; occurrence != nil {
+		window.WeekdayOccurrence = &kyklosv1alpha1.WeekdayOccurrence{Weekday: string(occurrence.Weekday), Nth: occurrence.Nth}
+	}
+	return window
+}
+
+// convertTimeWindowFrom converts a window from the Hub version
+func convertTimeWindowFrom(w kyklosv1alpha1.TimeWindow) (TimeWindow, error) {
+	days, err := engine.ExpandDays(w.Days)
+	if err != nil {
+		return TimeWindow{}, fmt.Errorf("days: %w", err)
+	}
+	startDay, err := canonicalWeekday(w.StartDay)
+	if err != nil {
+		return TimeWindow{}, fmt.Errorf("startDay: %w", err)
+	}
+	endDay, err := canonicalWeekday(w.EndDay)
+	if err != nil {
+		return TimeWindow{}, fmt.Errorf("endDay: %w", err)
+	}
+	var months []Month
+	if w.Months != nil {
+		months = make([]Month, len(w.Months))
+		for i, name := range w.Months {
+			if months[i], err = canonicalMonth(name); err != nil {
+				return TimeWindow{}, fmt.Errorf("months: %w", err)
+			}
+		}
+	}
+
+	window := TimeWindow{
+		Start:               TimeOfDay(w.Start),
+		End:                 TimeOfDay(w.End),
+		StartCron:           w.StartCron,
+		Duration:            w.Duration,
+		Replicas:            w.Replicas,
+		MaxReplicas:         w.MaxReplicas,
+		Days:                convertStrings[string, Weekday](days),
+		StartDay:            startDay,
+		EndDay:              endDay,
+		Months:              months,
+		StartDate:           Date(w.StartDate),
+		EndDate:             Date(w.EndDate),
+		DaysOfMonth:         w.DaysOfMonth,
+		BusinessDaysOfMonth: w.BusinessDaysOfMonth,
+		Priority:            w.Priority,
+		RampDuration:        w.RampDuration,
+		StepSize:            w.StepSize,
+		LeadTime:            w.LeadTime,
+		Name:                w.Name,
+	}
+	if occurrence := w.WeekdayOccurrence; occurrence != nil {
+		weekday, err := canonicalWeekday(occurrence.Weekday)
+		if err != nil {
+			return TimeWindow{}, fmt.Errorf("weekdayOccurrence.weekday: %w", err)
+		}
+		window.WeekdayOccurrence = &WeekdayOccurrence{Weekday: weekday, Nth: occurrence.Nth}
+	}
+	return window, nil
+}
+
+// canonicalWeekday converts a full or three-letter day name, in any case, to a Weekday; empty stays empty
+func canonicalWeekday(name string) (Weekday, error) {
+	if name == "" {
+		return "", nil
+	}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(name, day.String()) || strings.EqualFold(name, day.String()[:3]) {
+			return Weekday(day.String()), nil
+		}
+	}
+	return "", fmt.Errorf("invalid day '%s'", name)
+}
+
+// canonicalMonth converts a full or three-letter month name, in any case, to a Month
+func canonicalMonth(name string) (Month, error) {
+	for month := time.January; month <= time.December; month++ {
+		if strings.EqualFold(name, month.String()) || strings.EqualFold(name, month.String()[:3]) {
+			return Month(month.String()), nil
+		}
+	}
+	return "", fmt.Errorf("invalid month '%s'", name)
+}
+
+// convertStrings converts a slice of strings between string types, keeping nil slices nil
+func convertStrings[From, To ~string](values []From) []To {
+	if values == nil {
+		return nil
+	}
+	converted := make([]To, len(values))
+	for i, value := range values {
+		converted[i] = To(value)
+	}
+	return converted
+}
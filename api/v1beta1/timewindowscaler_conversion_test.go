@@ -0,0 +1,217 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+)
+
+func hubTimeWindowScaler() *kyklosv1alpha1.TimeWindowScaler {
+	replicas, maxReplicas, stepSize, grace := int32(3), int32(10), int32(2), int32(120)
+	holidays := "holidays"
+	now := metav1.NewTime(time.Date(2025, 3, 14, 10, 0, 0, 0, time.UTC))
+	return &kyklosv1alpha1.TimeWindowScaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", Generation: 4},
+		Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+			TargetRef:          kyklosv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "web", Namespace: "default"},
+			DefaultReplicas:    1,
+			DefaultMaxReplicas: &maxReplicas,
+			Timezone:           "Europe/Berlin",
+			DSTPolicy:          kyklosv1alpha1.DSTPolicySkip,
+			Windows: []kyklosv1alpha1.TimeWindow{
+				{Name: "business-hours", Start: "09:00", End: "17:00", Replicas: 5, MaxReplicas: &maxReplicas,
+					Days: []string{"Monday", "Friday"}, Months: []string{"December"}, StartDate: "2025-12-01",
+					EndDate: "2025-12-31", DaysOfMonth: []int32{1, -1}, BusinessDaysOfMonth: []int32{-1}, Priority: 2,
+					WeekdayOccurrence: &kyklosv1alpha1.WeekdayOccurrence{Weekday: "Friday", Nth: -1},
+					RampDuration:      &metav1.Duration{Duration: 30 * time.Minute}, StepSize: &stepSize,
+					LeadTime: &metav1.Duration{Duration: 4 * time.Minute}},
+				{Name: "weekend", Start: "18:00", End: "06:00", Replicas: 0, StartDay: "Friday", EndDay: "Monday"},
+				{Name: "batch", StartCron: "0 2 * * MON", Duration: &metav1.Duration{Duration: 4 * time.Hour}, Replicas: 8},
+			},
+			OneOffWindows: []kyklosv1alpha1.OneOffWindow{
+				{Name: "launch", Start: "2026-11-20T08:00", End: "2026-11-21T20:00", Replicas: 20, Priority: 5},
+			},
+			ConflictResolution:  kyklosv1alpha1.ConflictResolutionHighestPriority,
+			ExpiredWindowPolicy: kyklosv1alpha1.ExpiredWindowPolicyDelete,
+			LeadTime:            &metav1.Duration{Duration: 2 * time.Minute},
+			HolidayMode:         "treat-as-closed",
+			HolidayConfigMap:    &holidays,
+			GracePeriodSeconds:  &grace,
+			Override:            &kyklosv1alpha1.ReplicaOverride{Replicas: 7, Until: now},
+			DriftPolicy:         kyklosv1alpha1.DriftPolicyAllowUpOnly,
+			DeletionPolicy:      kyklosv1alpha1.DeletionPolicyRestoreOriginal,
+		},
+		Status: kyklosv1alpha1.TimeWindowScalerStatus{
+			ObservedGeneration: 4,
+			EffectiveReplicas:  &replicas,
+			Targets: []kyklosv1alpha1.TargetStatus{
+				{Kind: "Deployment", Name: "web", Namespace: "default", ObservedReplicas: &replicas, OriginalReplicas: &replicas},
+			},
+			CurrentWindow:  "business-hours",
+			MatchedWindows: []string{"business-hours"},
+			NextBoundary:   &now,
+			Upcoming: []kyklosv1alpha1.UpcomingTransition{
+				{Time: now, Window: "Default", Replicas: 1, Reason: "no-matching-window"},
+			},
+			LastScaleTime:  &now,
+			ExpiredWindows: []string{"launch"},
+			Holiday:        "Pi Day",
+			Conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Reconciled", LastTransitionTime: now},
+			},
+		},
+	}
+}
+
+func TestTimeWindowScalerConversion(t *testing.T) {
+	tests := []struct {
+		name string
+		hub  func() *kyklosv1alpha1.TimeWindowScaler
+	}{
+		{name: "Every field set", hub: hubTimeWindowScaler},
+		{name: "Empty", hub: func() *kyklosv1alpha1.TimeWindowScaler { return &kyklosv1alpha1.TimeWindowScaler{} }},
+		{name: "Schedule reference and selector", hub: func() *kyklosv1alpha1.TimeWindowScaler {
+			tws := hubTimeWindowScaler()
+			tws.Spec.TargetRef = kyklosv1alpha1.TargetRef{}
+			tws.Spec.Selector = &kyklosv1alpha1.TargetSelector{Kind: "StatefulSet",
+				LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}}
+			tws.Spec.ScheduleRef = &kyklosv1alpha1.ScheduleRef{Name: "office-hours"}
+			tws.Spec.Timezone = ""
+			tws.Spec.Windows = nil
+			tws.Spec.HolidayConfigMap = nil
+			return tws
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := tt.hub()
+			spoke := &TimeWindowScaler{}
+			if err := spoke.ConvertFrom(hub); err != nil {
+				t.Fatalf("ConvertFrom() error = %v", err)
+			}
+			roundTrip := &kyklosv1alpha1.TimeWindowScaler{}
+			if err := spoke.ConvertTo(roundTrip); err != nil {
+				t.Fatalf("ConvertTo() error = %v", err)
+			}
+			if !equality.Semantic.DeepEqual(hub, roundTrip) {
+				t.Errorf("round trip changed the object:\n got %+v\nwant %+v", roundTrip, hub)
+			}
+		})
+	}
+}
+
+func TestTimeWindowScalerConversionToSpoke(t *testing.T) {
+	spoke := &TimeWindowScaler{}
+	if err := spoke.ConvertFrom(hubTimeWindowScaler()); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+
+	if spoke.Spec.Schedule.Timezone != "Europe/Berlin" || spoke.Spec.Schedule.HolidayMode != HolidayModeTreatAsClosed {
+		t.Errorf("schedule = %+v, want the timezone and holiday mode moved into it", spoke.Spec.Schedule)
+	}
+	if spoke.Spec.GracePeriod == nil || spoke.Spec.GracePeriod.Duration != 2*time.Minute {
+		t.Errorf("gracePeriod = %v, want 2m", spoke.Spec.GracePeriod)
+	}
+	if days := spoke.Spec.Schedule.Windows[0].Days; len(days) != 2 || days[0] != "Monday" || days[1] != "Friday" {
+		t.Errorf("days = %v, want [Monday Friday]", days)
+	}
+}
+
+func TestTimeWindowScalerConversionNormalizesNames(t *testing.T) {
+	hub := hubTimeWindowScaler()
+	hub.Spec.Windows[0].Days = []string{"mon", "FRIDAY", "Tue-Wed"}
+	hub.Spec.Windows[0].Months = []string{"december", "Jan"}
+	hub.Spec.Windows[0].WeekdayOccurrence.Weekday = "friday"
+	hub.Spec.Windows[1].StartDay = "fri"
+	hub.Spec.Windows[1].EndDay = "monday"
+
+	spoke := &TimeWindowScaler{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+	windows := spoke.Spec.Schedule.Windows
+	if want := []Weekday{"Monday", "Friday", "Tuesday", "Wednesday"}; !equality.Semantic.DeepEqual(windows[0].Days, want) {
+		t.Errorf("days = %v, want %v", windows[0].Days, want)
+	}
+	if want := []Month{"December", "January"}; !equality.Semantic.DeepEqual(windows[0].Months, want) {
+		t.Errorf("months = %v, want %v", windows[0].Months, want)
+	}
+	if weekday := windows[0].WeekdayOccurrence.Weekday; weekday != "Friday" {
+		t.Errorf("weekdayOccurrence.weekday = %q, want Friday", weekday)
+	}
+	if windows[1].StartDay != "Friday" || windows[1].EndDay != "Monday" {
+		t.Errorf("startDay, endDay = %q, %q, want Friday, Monday", windows[1].StartDay, windows[1].EndDay)
+	}
+
+	// The round trip keeps the canonical names, and converting them again changes nothing
+	roundTrip := &kyklosv1alpha1.TimeWindowScaler{}
+	if err := spoke.ConvertTo(roundTrip); err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	if want := []string{"Monday", "Friday", "Tuesday", "Wednesday"}; !equality.Semantic.DeepEqual(roundTrip.Spec.Windows[0].Days, want) {
+		t.Errorf("round trip days = %v, want %v", roundTrip.Spec.Windows[0].Days, want)
+	}
+	again := &TimeWindowScaler{}
+	if err := again.ConvertFrom(roundTrip); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+	if !equality.Semantic.DeepEqual(spoke, again) {
+		t.Errorf("second conversion changed the object:\n got %+v\nwant %+v", again, spoke)
+	}
+}
+
+func TestTimeWindowScalerConversionInvalidNames(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(w *kyklosv1alpha1.TimeWindow)
+	}{
+		{name: "Day", mutate: func(w *kyklosv1alpha1.TimeWindow) { w.Days = []string{"mon", "funday"} }},
+		{name: "Month", mutate: func(w *kyklosv1alpha1.TimeWindow) { w.Months = []string{"Smarch"} }},
+		{name: "Start day", mutate: func(w *kyklosv1alpha1.TimeWindow) { w.StartDay = "weekend" }},
+		{name: "Weekday occurrence", mutate: func(w *kyklosv1alpha1.TimeWindow) {
+			w.WeekdayOccurrence = &kyklosv1alpha1.WeekdayOccurrence{Weekday: "Fri-Sat", Nth: 1}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := hubTimeWindowScaler()
+			tt.mutate(&hub.Spec.Windows[0])
+			if err := (&TimeWindowScaler{}).ConvertFrom(hub); err == nil {
+				t.Errorf("ConvertFrom() expected an error")
+			}
+		})
+	}
+}
+
+func TestGracePeriodDropsFractionsOfASecond(t *testing.T) {
+	spoke := &TimeWindowScaler{Spec: TimeWindowScalerSpec{GracePeriod: &metav1.Duration{Duration: 90*time.Second + 500*time.Millisecond}}}
+	hub := &kyklosv1alpha1.TimeWindowScaler{}
+	if err := spoke.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	if hub.Spec.GracePeriodSeconds == nil || *hub.Spec.GracePeriodSeconds != 90 {
+		t.Errorf("gracePeriodSeconds = %v, want 90", hub.Spec.GracePeriodSeconds)
+	}
+}
@@ -0,0 +1,481 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OneOffWindow) DeepCopyInto(out *OneOffWindow) {
+	*out = *in
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RampDuration != nil {
+		in, out := &in.RampDuration, &out.RampDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StepSize != nil {
+		in, out := &in.StepSize, &out.StepSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LeadTime != nil {
+		in, out := &in.LeadTime, &out.LeadTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OneOffWindow.
+func (in *OneOffWindow) DeepCopy() *OneOffWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(OneOffWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaOverride) DeepCopyInto(out *ReplicaOverride) {
+	*out = *in
+	in.Until.DeepCopyInto(&out.Until)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaOverride.
+func (in *ReplicaOverride) DeepCopy() *ReplicaOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	*out = *in
+	if in.Ref != nil {
+		in, out := &in.Ref, &out.Ref
+		*out = new(ScheduleRef)
+		**out = **in
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]TimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HolidayConfigMap != nil {
+		in, out := &in.HolidayConfigMap, &out.HolidayConfigMap
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleRef) DeepCopyInto(out *ScheduleRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleRef.
+func (in *ScheduleRef) DeepCopy() *ScheduleRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetRef) DeepCopyInto(out *TargetRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetRef.
+func (in *TargetRef) DeepCopy() *TargetRef {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSelector) DeepCopyInto(out *TargetSelector) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSelector.
+func (in *TargetSelector) DeepCopy() *TargetSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetStatus) DeepCopyInto(out *TargetStatus) {
+	*out = *in
+	if in.ObservedReplicas != nil {
+		in, out := &in.ObservedReplicas, &out.ObservedReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EffectiveReplicas != nil {
+		in, out := &in.EffectiveReplicas, &out.EffectiveReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OriginalReplicas != nil {
+		in, out := &in.OriginalReplicas, &out.OriginalReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OriginalMaxReplicas != nil {
+		in, out := &in.OriginalMaxReplicas, &out.OriginalMaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DriftAcceptedUntil != nil {
+		in, out := &in.DriftAcceptedUntil, &out.DriftAcceptedUntil
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetStatus.
+func (in *TargetStatus) DeepCopy() *TargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindow) DeepCopyInto(out *TimeWindow) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+	if in.Months != nil {
+		in, out := &in.Months, &out.Months
+		*out = make([]Month, len(*in))
+		copy(*out, *in)
+	}
+	if in.DaysOfMonth != nil {
+		in, out := &in.DaysOfMonth, &out.DaysOfMonth
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.BusinessDaysOfMonth != nil {
+		in, out := &in.BusinessDaysOfMonth, &out.BusinessDaysOfMonth
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.WeekdayOccurrence != nil {
+		in, out := &in.WeekdayOccurrence, &out.WeekdayOccurrence
+		*out = new(WeekdayOccurrence)
+		**out = **in
+	}
+	if in.RampDuration != nil {
+		in, out := &in.RampDuration, &out.RampDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StepSize != nil {
+		in, out := &in.StepSize, &out.StepSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LeadTime != nil {
+		in, out := &in.LeadTime, &out.LeadTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindow.
+func (in *TimeWindow) DeepCopy() *TimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindowScaler) DeepCopyInto(out *TimeWindowScaler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindowScaler.
+func (in *TimeWindowScaler) DeepCopy() *TimeWindowScaler {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindowScaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TimeWindowScaler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindowScalerList) DeepCopyInto(out *TimeWindowScalerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TimeWindowScaler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindowScalerList.
+func (in *TimeWindowScalerList) DeepCopy() *TimeWindowScalerList {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindowScalerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TimeWindowScalerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindowScalerSpec) DeepCopyInto(out *TimeWindowScalerSpec) {
+	*out = *in
+	out.TargetRef = in.TargetRef
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(TargetSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultMaxReplicas != nil {
+		in, out := &in.DefaultMaxReplicas, &out.DefaultMaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	in.Schedule.DeepCopyInto(&out.Schedule)
+	if in.OneOffWindows != nil {
+		in, out := &in.OneOffWindows, &out.OneOffWindows
+		*out = make([]OneOffWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LeadTime != nil {
+		in, out := &in.LeadTime, &out.LeadTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Override != nil {
+		in, out := &in.Override, &out.Override
+		*out = new(ReplicaOverride)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindowScalerSpec.
+func (in *TimeWindowScalerSpec) DeepCopy() *TimeWindowScalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindowScalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindowScalerStatus) DeepCopyInto(out *TimeWindowScalerStatus) {
+	*out = *in
+	if in.EffectiveReplicas != nil {
+		in, out := &in.EffectiveReplicas, &out.EffectiveReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EffectiveMaxReplicas != nil {
+		in, out := &in.EffectiveMaxReplicas, &out.EffectiveMaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetObservedReplicas != nil {
+		in, out := &in.TargetObservedReplicas, &out.TargetObservedReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MatchedWindows != nil {
+		in, out := &in.MatchedWindows, &out.MatchedWindows
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextBoundary != nil {
+		in, out := &in.NextBoundary, &out.NextBoundary
+		*out = (*in).DeepCopy()
+	}
+	if in.Upcoming != nil {
+		in, out := &in.Upcoming, &out.Upcoming
+		*out = make([]UpcomingTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.GracePeriodExpiry != nil {
+		in, out := &in.GracePeriodExpiry, &out.GracePeriodExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiredWindows != nil {
+		in, out := &in.ExpiredWindows, &out.ExpiredWindows
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindowScalerStatus.
+func (in *TimeWindowScalerStatus) DeepCopy() *TimeWindowScalerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindowScalerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpcomingTransition) DeepCopyInto(out *UpcomingTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpcomingTransition.
+func (in *UpcomingTransition) DeepCopy() *UpcomingTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(UpcomingTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeekdayOccurrence) DeepCopyInto(out *WeekdayOccurrence) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeekdayOccurrence.
+func (in *WeekdayOccurrence) DeepCopy() *WeekdayOccurrence {
+	if in == nil {
+		return nil
+	}
+	out := new(WeekdayOccurrence)
+	in.DeepCopyInto(out)
+	return out
+}
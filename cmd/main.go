@@ -38,7 +38,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+	kyklosv1beta1 "github.com/roguepikachu/kyklos/api/v1beta1"
 	"github.com/roguepikachu/kyklos/internal/controller"
+	webhookkyklosv1alpha1 "github.com/roguepikachu/kyklos/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -51,6 +53,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(kyklosv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(kyklosv1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -210,6 +213,21 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "TimeWindowScaler")
 		os.Exit(1)
 	}
+	if err := (&controller.ClusterTimeWindowScalerReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("clustertimewindowscaler-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterTimeWindowScaler")
+		os.Exit(1)
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := webhookkyklosv1alpha1.SetupTimeWindowScalerWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "TimeWindowScaler")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if metricsCertWatcher != nil {
@@ -0,0 +1,528 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+	"github.com/roguepikachu/kyklos/internal/engine"
+	"github.com/roguepikachu/kyklos/internal/windowspec"
+)
+
+// ClusterTimeWindowScalerReconciler reconciles a ClusterTimeWindowScaler object
+type ClusterTimeWindowScalerReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Clock    engine.Clock
+}
+
+// +kubebuilder:rbac:groups=kyklos.kyklos.io,resources=clustertimewindowscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kyklos.kyklos.io,resources=clustertimewindowscalers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kyklos.kyklos.io,resources=clustertimewindowscalers/finalizers,verbs=update
+
+// Reconcile scales every workload selected by a ClusterTimeWindowScaler
+func (r *ClusterTimeWindowScalerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Fetch the ClusterTimeWindowScaler instance
+	ctws := &kyklosv1alpha1.ClusterTimeWindowScaler{}
+	if err := r.Get(ctx, req.NamespacedName, ctws); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("ClusterTimeWindowScaler not found, may have been deleted", "name", req.Name)
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get ClusterTimeWindowScaler", "name", req.Name)
+		return ctrl.Result{}, err
+	}
+
+	// Initialize clock if not set (for testing)
+	if r.Clock == nil {
+		r.Clock = engine.RealClock{}
+	}
+
+	// Check if the object is being deleted
+	if ctws.ObjectMeta.DeletionTimestamp != nil {
+		return r.handleDeletion(ctx, ctws)
+	}
+
+	// Add finalizer if not present
+	if !containsString(ctws.ObjectMeta.Finalizers, timeWindowScalerFinalizer) {
+		ctws.ObjectMeta.Finalizers = append(ctws.ObjectMeta.Finalizers, timeWindowScalerFinalizer)
+		if err := r.Update(ctx, ctws); err != nil {
+			logger.Error(err, "Failed to add finalizer")
+			return ctrl.Result{}, err
+		}
+		// Requeue to continue processing
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Select the target workloads across namespaces
+	targets, err := selectTargetWorkloads(ctx, r.Client, &ctws.Spec.Selector, "")
+	if err != nil {
+		logger.Error(err, "Failed to select target workloads")
+		r.setErrorCondition(ctws, "InvalidTarget", err.Error())
+		if statusErr := r.Status().Update(ctx, ctws); statusErr != nil {
+			logger.Error(statusErr, "Failed to update status after target selection error")
+		}
+		r.Recorder.Event(ctws, corev1.EventTypeWarning, "InvalidTarget", err.Error())
+		return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	}
+
+	// Build the engine input shared by all targets
+	windows, err := windowspec.ConvertRecurring(ctws.Spec.Windows)
+	var lead *time.Duration
+	if err == nil {
+		if lead, err = windowspec.ConvertLeadTime(ctws.Spec.LeadTime); err != nil {
+			err = fmt.Errorf("spec %w", err)
+		}
+	}
+	if err != nil {
+		logger.Error(err, "Invalid window")
+		r.setErrorCondition(ctws, "InvalidConfiguration", err.Error())
+		if statusErr := r.Status().Update(ctx, ctws); statusErr != nil {
+			logger.Error(statusErr, "Failed to update status after build input error")
+		}
+		r.Recorder.Event(ctws, corev1.EventTypeWarning, "InvalidWindow", err.Error())
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	}
+	engineInput := engine.Input{
+		Now:                r.Clock.Now(),
+		Timezone:           ctws.Spec.Timezone,
+		DSTPolicy:          ctws.Spec.DSTPolicy,
+		Windows:            windows,
+		DefaultReplicas:    ctws.Spec.DefaultReplicas,
+		DefaultMaxReplicas: ctws.Spec.DefaultMaxReplicas,
+		Pause:              ctws.Spec.Pause,
+		ConflictResolution: ctws.Spec.ConflictResolution,
+	}
+	if lead != nil {
+		engineInput.LeadTime = *lead
+	}
+	if ctws.Spec.GracePeriodSeconds != nil {
+		engineInput.GracePeriodSecs = *ctws.Spec.GracePeriodSeconds
+	}
+	if ctws.Status.LastScaleTime != nil {
+		engineInput.LastScaleTime = &ctws.Status.LastScaleTime.Time
+	}
+
+	engineOutput, err := engine.ComputeEffectiveReplicas(engineInput)
+	if err != nil {
+		logger.Error(err, "Failed to compute effective replicas")
+		r.setErrorCondition(ctws, "ComputeFailed",
+			fmt.Sprintf("Failed to compute effective replicas: %v", err))
+		if statusErr := r.Status().Update(ctx, ctws); statusErr != nil {
+			logger.Error(statusErr, "Failed to update status after compute error")
+		}
+		r.Recorder.Event(ctws, corev1.EventTypeWarning, "ComputeFailed",
+			fmt.Sprintf("Failed to compute effective replicas: %v", err))
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	}
+
+	logger.Info("Computed scaling decision",
+		"targets", len(targets),
+		"nextBoundary", engineOutput.NextBoundary.Format(time.RFC3339),
+		"effectiveReplicas", engineOutput.EffectiveReplicas,
+		"currentWindow", engineOutput.CurrentWindow,
+		"reason", engineOutput.Reason)
+
+	// Apply the decision to every target; grace periods are tracked per target
+	targetStatuses := make([]kyklosv1alpha1.ClusterTargetStatus, 0, len(targets))
+	var scaleErrs []error
+	nextBoundary := engineOutput.NextBoundary
+	for _, target := range targets {
+		targetInput := engineInput
+		targetInput.CurrentReplicas = previousClusterObservedReplicas(ctws.Status.Targets, target)
+		targetOutput, err := engine.ComputeEffectiveReplicas(targetInput)
+		if err != nil {
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+		}
+		if targetOutput.NextBoundary.Before(nextBoundary) {
+			nextBoundary = targetOutput.NextBoundary
+		}
+
+		targetStatus, err := r.applyToTarget(ctx, ctws, target, targetOutput)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// Deleted since it was listed
+				continue
+			}
+			scaleErrs = append(scaleErrs, err)
+		}
+		targetStatuses = append(targetStatuses, targetStatus)
+	}
+
+	// Update status
+	ctws.Status.ObservedGeneration = ctws.Generation
+	ctws.Status.EffectiveReplicas = &engineOutput.EffectiveReplicas
+	ctws.Status.EffectiveMaxReplicas = engineOutput.EffectiveMaxReplicas
+	ctws.Status.Targets = targetStatuses
+	ctws.Status.CurrentWindow = engineOutput.CurrentWindow
+	ctws.Status.MatchedWindows = engineOutput.MatchedWindows
+	nextBoundaryTime := metav1.NewTime(nextBoundary)
+	ctws.Status.NextBoundary = &nextBoundaryTime
+	ctws.Status.Upcoming = upcomingStatus(engineInput, nil)
+
+	readyCondition := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: ctws.Generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             "Reconciled",
+		Message: fmt.Sprintf("ClusterTimeWindowScaler is ready, window: %s, targets: %d",
+			engineOutput.CurrentWindow, len(targetStatuses)),
+	}
+	if ctws.Spec.Pause {
+		readyCondition.Reason = "Paused"
+		readyCondition.Message = "ClusterTimeWindowScaler is paused"
+	}
+	meta.SetStatusCondition(&ctws.Status.Conditions, readyCondition)
+	if len(scaleErrs) > 0 {
+		err = errors.Join(scaleErrs...)
+		r.setErrorCondition(ctws, "ScaleFailed", err.Error())
+	}
+
+	if statusErr := r.Status().Update(ctx, ctws); statusErr != nil {
+		return ctrl.Result{}, statusErr
+	}
+	if err != nil {
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	}
+
+	// Requeue just before the next boundary; namespace and workload watches pick up selection changes
+	requeueAfter := nextBoundary.Sub(r.Clock.Now()) - 10*time.Second
+	if requeueAfter < 30*time.Second {
+		requeueAfter = 30 * time.Second
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// applyToTarget scales one selected workload to the computed replicas (unless paused)
+func (r *ClusterTimeWindowScalerReconciler) applyToTarget(ctx context.Context, ctws *kyklosv1alpha1.ClusterTimeWindowScaler, target *targetWorkload, engineOutput engine.Output) (kyklosv1alpha1.ClusterTargetStatus, error) {
+	logger := log.FromContext(ctx)
+
+	targetStatus := kyklosv1alpha1.ClusterTargetStatus{
+		Kind:      target.GroupVersionKind.Kind,
+		Name:      target.Name,
+		Namespace: target.Namespace,
+	}
+
+	current, err := readTargetReplicas(ctx, r.Client, target)
+	if err != nil {
+		targetStatus.Message = fmt.Sprintf("Failed to get scale of %s: %v", target, err)
+		return targetStatus, err
+	}
+	desired := current.withBounds(engineOutput.EffectiveReplicas, engineOutput.EffectiveMaxReplicas)
+	targetStatus.ObservedReplicas = &current.Replicas
+	targetStatus.EffectiveReplicas = &desired.Replicas
+	targetStatus.OriginalReplicas, targetStatus.OriginalMaxReplicas = r.ensureOriginalReplicas(ctx, ctws, target, current)
+
+	if desired.equal(current) || ctws.Spec.Pause {
+		return targetStatus, nil
+	}
+
+	if err := writeTargetReplicas(ctx, r.Client, target, desired); err != nil {
+		logger.Error(err, "Failed to scale target",
+			"target", target.String(),
+			"from", current.String(),
+			"to", desired.String())
+		message := fmt.Sprintf("Failed to scale %s from %s to %s: %v", target, current, desired, err)
+		r.Recorder.Event(ctws, corev1.EventTypeWarning, "ScaleFailed", message)
+		targetStatus.Message = message
+		return targetStatus, fmt.Errorf("%s", message)
+	}
+
+	eventType := "ScaledUp"
+	if desired.Replicas < current.Replicas {
+		eventType = "ScaledDown"
+	}
+	r.Recorder.Event(ctws, corev1.EventTypeNormal, eventType,
+		fmt.Sprintf("Scaled %s from %s to %s (window: %s)",
+			target, current, desired, engineOutput.CurrentWindow))
+
+	ctws.Status.LastScaleTime = &metav1.Time{Time: r.Clock.Now()}
+	targetStatus.ObservedReplicas = &desired.Replicas
+	return targetStatus, nil
+}
+
+// handleDeletion applies the deletion policy to the selected workloads and removes the finalizer
+func (r *ClusterTimeWindowScalerReconciler) handleDeletion(ctx context.Context, ctws *kyklosv1alpha1.ClusterTimeWindowScaler) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if containsString(ctws.ObjectMeta.Finalizers, timeWindowScalerFinalizer) {
+		logger.Info("Performing cleanup for ClusterTimeWindowScaler", "name", ctws.Name)
+
+		// Emit deletion event
+		r.Recorder.Event(ctws, corev1.EventTypeNormal, "Deleting",
+			"ClusterTimeWindowScaler is being deleted, cleaning up resources")
+
+		// Apply the deletion policy to the targets
+		if err := r.applyDeletionPolicy(ctx, ctws); err != nil {
+			logger.Error(err, "Failed to apply deletion policy")
+			r.Recorder.Event(ctws, corev1.EventTypeWarning, "DeletionPolicyFailed",
+				fmt.Sprintf("Failed to apply deletion policy %s: %v", ctws.Spec.DeletionPolicy, err))
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+		}
+
+		// Remove finalizer
+		ctws.ObjectMeta.Finalizers = removeString(ctws.ObjectMeta.Finalizers, timeWindowScalerFinalizer)
+		if err := r.Update(ctx, ctws); err != nil {
+			logger.Error(err, "Failed to remove finalizer")
+			return ctrl.Result{}, err
+		}
+
+		logger.Info("Successfully cleaned up ClusterTimeWindowScaler", "name", ctws.Name)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// applyDeletionPolicy scales the selected workloads according to spec.deletionPolicy and removes the
+// original replicas annotations; workloads that no longer exist are skipped
+func (r *ClusterTimeWindowScalerReconciler) applyDeletionPolicy(ctx context.Context, ctws *kyklosv1alpha1.ClusterTimeWindowScaler) error {
+	targets, err := selectTargetWorkloads(ctx, r.Client, &ctws.Spec.Selector, "")
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			// The target kind no longer exists, so there is nothing to restore
+			return nil
+		}
+		return err
+	}
+
+	for _, target := range targets {
+		current, err := readTargetReplicas(ctx, r.Client, target)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		var replicas, maxReplicas *int32
+		switch ctws.Spec.DeletionPolicy {
+		case kyklosv1alpha1.DeletionPolicyRestoreOriginal:
+			replicas, maxReplicas = r.originalReplicas(ctx, ctws, target)
+		case kyklosv1alpha1.DeletionPolicySetDefault:
+			replicas, maxReplicas = &ctws.Spec.DefaultReplicas, ctws.Spec.DefaultMaxReplicas
+		}
+
+		if replicas != nil {
+			desired := current.withBounds(*replicas, maxReplicas)
+			if !desired.equal(current) {
+				if err := writeTargetReplicas(ctx, r.Client, target, desired); err != nil {
+					return fmt.Errorf("failed to scale %s from %s to %s: %w", target, current, desired, err)
+				}
+				r.Recorder.Event(ctws, corev1.EventTypeNormal, "Restored",
+					fmt.Sprintf("Scaled %s from %s to %s (deletion policy: %s)",
+						target, current, desired, ctws.Spec.DeletionPolicy))
+			}
+		}
+
+		if !target.keepsOriginalAnnotations() {
+			continue
+		}
+		if err := clearOriginalReplicas(ctx, r.Client, target); err != nil && !apierrors.IsNotFound(err) {
+			log.FromContext(ctx).Error(err, "Failed to remove original replicas annotations",
+				"target", target.String())
+		}
+	}
+
+	return nil
+}
+
+// originalReplicas returns the pre-management replicas of a workload from status or, failing that,
+// from the annotations on the workload; nil when they were never recorded
+func (r *ClusterTimeWindowScalerReconciler) originalReplicas(ctx context.Context, ctws *kyklosv1alpha1.ClusterTimeWindowScaler, target *targetWorkload) (*int32, *int32) {
+	for _, ts := range ctws.Status.Targets {
+		if ts.Namespace == target.Namespace && ts.Name == target.Name && ts.OriginalReplicas != nil {
+			return ts.OriginalReplicas, ts.OriginalMaxReplicas
+		}
+	}
+	if !target.keepsOriginalAnnotations() {
+		return nil, nil
+	}
+	replicas, maxReplicas, err := readOriginalReplicas(ctx, r.Client, target)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to read original replicas annotations",
+			"target", target.String())
+		return nil, nil
+	}
+	return replicas, maxReplicas
+}
+
+// ensureOriginalReplicas returns the pre-management replicas of a workload, recording the
+// current replicas in annotations on the workload the first time it is managed
+func (r *ClusterTimeWindowScalerReconciler) ensureOriginalReplicas(ctx context.Context, ctws *kyklosv1alpha1.ClusterTimeWindowScaler, target *targetWorkload, current *targetReplicas) (*int32, *int32) {
+	if replicas, maxReplicas := r.originalReplicas(ctx, ctws, target); replicas != nil {
+		return replicas, maxReplicas
+	}
+
+	original := *current
+	if !target.keepsOriginalAnnotations() {
+		return &original.Replicas, original.MaxReplicas
+	}
+	if err := recordOriginalReplicas(ctx, r.Client, target, &original); err != nil {
+		// The status entry still records the original replicas
+		log.FromContext(ctx).Error(err, "Failed to record original replicas annotations",
+			"target", target.String())
+	}
+	return &original.Replicas, original.MaxReplicas
+}
+
+// previousClusterObservedReplicas returns the replicas recorded for a target by the last reconcile
+func previousClusterObservedReplicas(statuses []kyklosv1alpha1.ClusterTargetStatus, target *targetWorkload) int32 {
+	for _, ts := range statuses {
+		if ts.Namespace == target.Namespace && ts.Name == target.Name && ts.ObservedReplicas != nil {
+			return *ts.ObservedReplicas
+		}
+	}
+	return 0
+}
+
+// setErrorCondition sets an error condition on the ClusterTimeWindowScaler
+func (r *ClusterTimeWindowScalerReconciler) setErrorCondition(ctws *kyklosv1alpha1.ClusterTimeWindowScaler, reason, message string) {
+	meta.SetStatusCondition(&ctws.Status.Conditions, metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: ctws.Generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// findClusterTimeWindowScalersForNamespace re-enqueues every ClusterTimeWindowScaler when a namespace changes,
+// since label or opt-out annotation changes can alter which namespaces are selected
+func (r *ClusterTimeWindowScalerReconciler) findClusterTimeWindowScalersForNamespace(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := log.FromContext(ctx)
+
+	ctwsList := &kyklosv1alpha1.ClusterTimeWindowScalerList{}
+	if err := r.List(ctx, ctwsList); err != nil {
+		logger.Error(err, "Failed to list ClusterTimeWindowScalers for namespace change", "namespace", obj.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(ctwsList.Items))
+	for _, ctws := range ctwsList.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: ctws.Name},
+		})
+	}
+	return requests
+}
+
+// findClusterTimeWindowScalersForTarget returns a map function enqueueing the ClusterTimeWindowScalers
+// whose selector matches a workload of the given kind. Update events map both the old and the new
+// object, so workloads relabelled out of a selector are released as well.
+func (r *ClusterTimeWindowScalerReconciler) findClusterTimeWindowScalersForTarget(kind string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		logger := log.FromContext(ctx)
+
+		ctwsList := &kyklosv1alpha1.ClusterTimeWindowScalerList{}
+		if err := r.List(ctx, ctwsList); err != nil {
+			logger.Error(err, "Failed to list ClusterTimeWindowScalers for target change",
+				"kind", kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+			return nil
+		}
+
+		var namespace *corev1.Namespace
+		var requests []reconcile.Request
+		for i := range ctwsList.Items {
+			sel := &ctwsList.Items[i].Spec.Selector
+			if targetKindOf(kyklosv1alpha1.TargetRef{Kind: sel.Kind}) != kind {
+				continue
+			}
+			selector, err := metav1.LabelSelectorAsSelector(&sel.LabelSelector)
+			if err != nil || !selector.Matches(labels.Set(obj.GetLabels())) {
+				continue
+			}
+			if sel.NamespaceSelector != nil {
+				if namespace == nil {
+					namespace = &corev1.Namespace{}
+					if err := r.Get(ctx, client.ObjectKey{Name: obj.GetNamespace()}, namespace); err != nil {
+						logger.Error(err, "Failed to get namespace for target change", "namespace", obj.GetNamespace())
+						return requests
+					}
+				}
+				nsSelector, err := metav1.LabelSelectorAsSelector(sel.NamespaceSelector)
+				if err != nil || !nsSelector.Matches(labels.Set(namespace.Labels)) {
+					continue
+				}
+			}
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: ctwsList.Items[i].Name},
+			})
+		}
+		return requests
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterTimeWindowScalerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Selection changes are driven by labels and the opt-out annotation
+	selectionPredicate := builder.WithPredicates(predicate.Or(predicate.LabelChangedPredicate{}, predicate.AnnotationChangedPredicate{}))
+	// Workload changes are also picked up when their spec (e.g. replicas) changes, so manual scaling is corrected
+	targetPredicate := builder.WithPredicates(targetChangedPredicate)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&kyklosv1alpha1.ClusterTimeWindowScaler{}).
+		Watches(
+			&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.findClusterTimeWindowScalersForNamespace),
+			selectionPredicate,
+		).
+		Watches(
+			&appsv1.Deployment{},
+			handler.EnqueueRequestsFromMapFunc(r.findClusterTimeWindowScalersForTarget(kyklosv1alpha1.TargetKindDeployment)),
+			targetPredicate,
+		).
+		Watches(
+			&appsv1.StatefulSet{},
+			handler.EnqueueRequestsFromMapFunc(r.findClusterTimeWindowScalersForTarget(kyklosv1alpha1.TargetKindStatefulSet)),
+			targetPredicate,
+		).
+		Watches(
+			&autoscalingv2.HorizontalPodAutoscaler{},
+			handler.EnqueueRequestsFromMapFunc(r.findClusterTimeWindowScalersForTarget(kyklosv1alpha1.TargetKindHorizontalPodAutoscaler)),
+			targetPredicate,
+		).
+		Named("clustertimewindowscaler").
+		Complete(r)
+}
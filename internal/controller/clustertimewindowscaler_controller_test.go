@@ -0,0 +1,394 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+	"github.com/roguepikachu/kyklos/internal/engine"
+)
+
+var _ = Describe("ClusterTimeWindowScaler Controller", func() {
+	Context("When scaling workloads across namespaces", func() {
+		var (
+			ctx        context.Context
+			suffix     string
+			reconciler *ClusterTimeWindowScalerReconciler
+		)
+
+		createNamespace := func(name string, labels, annotations map[string]string) {
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Labels:      labels,
+					Annotations: annotations,
+				},
+			}
+			Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+		}
+
+		createDeployment := func(name, namespace string, annotations map[string]string) {
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Namespace:   namespace,
+					Annotations: annotations,
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr(2),
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app": name,
+						},
+					},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"app": name,
+							},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  "test",
+									Image: "nginx:latest",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+		}
+
+		replicasOf := func(name, namespace string) int32 {
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, deployment)).To(Succeed())
+			return *deployment.Spec.Replicas
+		}
+
+		reconcileScaler := func(req reconcile.Request) {
+			// The first reconcile adds the finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		}
+
+		deleteScaler := func(ctws *kyklosv1alpha1.ClusterTimeWindowScaler) {
+			current := &kyklosv1alpha1.ClusterTimeWindowScaler{}
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(ctws), current); err != nil {
+				return
+			}
+			current.Finalizers = nil
+			_ = k8sClient.Update(ctx, current)
+			_ = k8sClient.Delete(ctx, current)
+		}
+
+		newScaler := func(name, namespaceLabel string) *kyklosv1alpha1.ClusterTimeWindowScaler {
+			return &kyklosv1alpha1.ClusterTimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: kyklosv1alpha1.ClusterTimeWindowScalerSpec{
+					Selector: kyklosv1alpha1.TargetSelector{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{namespaceLabel: "true"},
+						},
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 0,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{Start: "07:00", End: "20:00", Replicas: 2, Name: "working-hours"},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			suffix = fmt.Sprintf("%d", time.Now().UnixNano())
+
+			// Monday 23:00 UTC is outside the working-hours window
+			reconciler = &ClusterTimeWindowScalerReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(100),
+				Clock:    engine.FakeClock{Time: time.Date(2025, 3, 10, 23, 0, 0, 0, time.UTC)},
+			}
+		})
+
+		It("Should scale selected namespaces and honour opt-out annotations", func() {
+			devNamespace := "dev-" + suffix
+			optedOutNamespace := "dev-opted-out-" + suffix
+			prodNamespace := "prod-" + suffix
+			envLabel := "env-" + suffix
+
+			createNamespace(devNamespace, map[string]string{envLabel: "dev"}, nil)
+			createNamespace(optedOutNamespace, map[string]string{envLabel: "dev"},
+				map[string]string{kyklosv1alpha1.OptOutAnnotation: "true"})
+			createNamespace(prodNamespace, map[string]string{envLabel: "prod"}, nil)
+
+			createDeployment("api", devNamespace, nil)
+			createDeployment("pinned", devNamespace, map[string]string{kyklosv1alpha1.OptOutAnnotation: "true"})
+			createDeployment("api", optedOutNamespace, nil)
+			createDeployment("api", prodNamespace, nil)
+
+			ctws := &kyklosv1alpha1.ClusterTimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "dev-nights-" + suffix,
+				},
+				Spec: kyklosv1alpha1.ClusterTimeWindowScalerSpec{
+					Selector: kyklosv1alpha1.TargetSelector{
+						APIVersion: "apps/v1",
+						Kind:       kyklosv1alpha1.TargetKindDeployment,
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{envLabel: "dev"},
+						},
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 0,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:    "07:00",
+							End:      "20:00",
+							Replicas: 2,
+							Name:     "working-hours",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, ctws)).To(Succeed())
+			defer deleteScaler(ctws)
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: ctws.Name}}
+			reconcileScaler(req)
+
+			// Only the selected, non-opted-out deployment scales to zero
+			Expect(replicasOf("api", devNamespace)).To(Equal(int32(0)))
+			Expect(replicasOf("pinned", devNamespace)).To(Equal(int32(2)))
+			Expect(replicasOf("api", optedOutNamespace)).To(Equal(int32(2)))
+			Expect(replicasOf("api", prodNamespace)).To(Equal(int32(2)))
+
+			updated := &kyklosv1alpha1.ClusterTimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.Targets).To(HaveLen(1))
+			Expect(updated.Status.Targets[0].Namespace).To(Equal(devNamespace))
+			Expect(updated.Status.Targets[0].Name).To(Equal("api"))
+			Expect(updated.Status.CurrentWindow).To(Equal("Default"))
+			Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, "Ready")).To(BeTrue())
+		})
+
+		It("Should not scale when paused", func() {
+			namespace := "paused-" + suffix
+			createNamespace(namespace, map[string]string{"paused-" + suffix: "true"}, nil)
+			createDeployment("api", namespace, nil)
+
+			ctws := &kyklosv1alpha1.ClusterTimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "paused-" + suffix,
+				},
+				Spec: kyklosv1alpha1.ClusterTimeWindowScalerSpec{
+					Selector: kyklosv1alpha1.TargetSelector{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"paused-" + suffix: "true"},
+						},
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 0,
+					Pause:           true,
+				},
+			}
+			Expect(k8sClient.Create(ctx, ctws)).To(Succeed())
+			defer deleteScaler(ctws)
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: ctws.Name}}
+			reconcileScaler(req)
+
+			Expect(replicasOf("api", namespace)).To(Equal(int32(2)))
+
+			updated := &kyklosv1alpha1.ClusterTimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updated)).To(Succeed())
+			condition := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal("Paused"))
+		})
+
+		It("Should revert manual scaling of a selected workload", func() {
+			namespace := "drift-" + suffix
+			createNamespace(namespace, map[string]string{"drift-" + suffix: "true"}, nil)
+			createDeployment("api", namespace, nil)
+
+			ctws := newScaler("drift-"+suffix, "drift-"+suffix)
+			Expect(k8sClient.Create(ctx, ctws)).To(Succeed())
+			defer deleteScaler(ctws)
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: ctws.Name}}
+			reconcileScaler(req)
+			Expect(replicasOf("api", namespace)).To(Equal(int32(0)))
+
+			// Scale the deployment by hand
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "api", Namespace: namespace}, deployment)).To(Succeed())
+			deployment.Spec.Replicas = ptr(3)
+			Expect(k8sClient.Update(ctx, deployment)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replicasOf("api", namespace)).To(Equal(int32(0)))
+
+			updated := &kyklosv1alpha1.ClusterTimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.Targets).To(HaveLen(1))
+			Expect(*updated.Status.Targets[0].ObservedReplicas).To(Equal(int32(0)))
+			Expect(*updated.Status.Targets[0].OriginalReplicas).To(Equal(int32(2)))
+		})
+
+		It("Should restore the original replicas when deleted with RestoreOriginal", func() {
+			namespace := "restore-" + suffix
+			createNamespace(namespace, map[string]string{"restore-" + suffix: "true"}, nil)
+			createDeployment("api", namespace, nil)
+
+			ctws := newScaler("restore-"+suffix, "restore-"+suffix)
+			ctws.Spec.DeletionPolicy = kyklosv1alpha1.DeletionPolicyRestoreOriginal
+			Expect(k8sClient.Create(ctx, ctws)).To(Succeed())
+			defer deleteScaler(ctws)
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: ctws.Name}}
+			reconcileScaler(req)
+			Expect(replicasOf("api", namespace)).To(Equal(int32(0)))
+
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "api", Namespace: namespace}, deployment)).To(Succeed())
+			Expect(deployment.Annotations).To(HaveKeyWithValue(kyklosv1alpha1.OriginalReplicasAnnotation, "2"))
+
+			// Deleting waits for the finalizer, which restores the deployment
+			Expect(k8sClient.Delete(ctx, ctws)).To(Succeed())
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "api", Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(2)))
+			Expect(deployment.Annotations).NotTo(HaveKey(kyklosv1alpha1.OriginalReplicasAnnotation))
+
+			err = k8sClient.Get(ctx, req.NamespacedName, &kyklosv1alpha1.ClusterTimeWindowScaler{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("Should leave the workloads scaled when deleted with Retain", func() {
+			namespace := "retain-" + suffix
+			createNamespace(namespace, map[string]string{"retain-" + suffix: "true"}, nil)
+			createDeployment("api", namespace, nil)
+
+			ctws := newScaler("retain-"+suffix, "retain-"+suffix)
+			Expect(k8sClient.Create(ctx, ctws)).To(Succeed())
+			defer deleteScaler(ctws)
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: ctws.Name}}
+			reconcileScaler(req)
+			Expect(replicasOf("api", namespace)).To(Equal(int32(0)))
+
+			Expect(k8sClient.Delete(ctx, ctws)).To(Succeed())
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(replicasOf("api", namespace)).To(Equal(int32(0)))
+			err = k8sClient.Get(ctx, req.NamespacedName, &kyklosv1alpha1.ClusterTimeWindowScaler{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("ClusterTimeWindowScaler target watch mapping", func() {
+	It("Should map a workload to the cluster scalers selecting it", func() {
+		ctx := context.Background()
+
+		newCTWS := func(name, kind string, labels, namespaceLabels map[string]string) *kyklosv1alpha1.ClusterTimeWindowScaler {
+			return &kyklosv1alpha1.ClusterTimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: kyklosv1alpha1.ClusterTimeWindowScalerSpec{
+					Selector: kyklosv1alpha1.TargetSelector{
+						Kind:              kind,
+						LabelSelector:     metav1.LabelSelector{MatchLabels: labels},
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: namespaceLabels},
+					},
+				},
+			}
+		}
+
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithObjects(
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"env": "dev"}}},
+				newCTWS("dev-web", "", map[string]string{"tier": "web"}, map[string]string{"env": "dev"}),
+				newCTWS("all-namespaces", kyklosv1alpha1.TargetKindDeployment, nil, nil),
+				newCTWS("prod-web", "", map[string]string{"tier": "web"}, map[string]string{"env": "prod"}),
+				newCTWS("dev-db", "", map[string]string{"tier": "db"}, map[string]string{"env": "dev"}),
+				newCTWS("dev-statefulsets", kyklosv1alpha1.TargetKindStatefulSet, nil, map[string]string{"env": "dev"}),
+			).
+			Build()
+		reconciler := &ClusterTimeWindowScalerReconciler{Client: fakeClient}
+
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "web",
+				Namespace: "team-a",
+				Labels:    map[string]string{"tier": "web"},
+			},
+		}
+		requests := reconciler.findClusterTimeWindowScalersForTarget(kyklosv1alpha1.TargetKindDeployment)(ctx, deployment)
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "dev-web"}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "all-namespaces"}},
+		))
+	})
+
+	It("Should pass manual scaling of a workload but not status updates", func() {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "team-a", Generation: 1},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr(2)},
+		}
+
+		scaled := deployment.DeepCopy()
+		scaled.Generation = 2
+		scaled.Spec.Replicas = ptr(5)
+		Expect(targetChangedPredicate.Update(event.UpdateEvent{ObjectOld: deployment, ObjectNew: scaled})).To(BeTrue())
+
+		statusOnly := deployment.DeepCopy()
+		statusOnly.Status.ReadyReplicas = 2
+		Expect(targetChangedPredicate.Update(event.UpdateEvent{ObjectOld: deployment, ObjectNew: statusOnly})).To(BeFalse())
+	})
+})
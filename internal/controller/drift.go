@@ -0,0 +1,110 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+)
+
+// driftDecision is the outcome of evaluating a target against the drift policy
+type driftDecision struct {
+	// keep leaves the target at its current replicas instead of the computed ones
+	keep bool
+	// acceptedUntil is when drift allowed until the next boundary is reverted
+	acceptedUntil *metav1.Time
+	// detected is set when a manual change was noticed in this reconcile
+	detected bool
+	// message describes the drift; empty when the target has not drifted
+	message string
+}
+
+// driftPolicyOf returns the drift policy of a TWS, defaulting to Revert
+func driftPolicyOf(tws *kyklosv1alpha1.TimeWindowScaler) string {
+	if tws.Spec.DriftPolicy == "" {
+		return kyklosv1alpha1.DriftPolicyRevert
+	}
+	return tws.Spec.DriftPolicy
+}
+
+// evaluateDrift compares a target with the replicas recorded by the last reconcile and decides,
+// according to the drift policy, whether a manual change is reverted or kept.
+// specChanged drops drift accepted earlier, so a new policy or override takes effect immediately.
+func evaluateDrift(policy string, previous *kyklosv1alpha1.TargetStatus, target *targetWorkload, current, desired *targetReplicas, now, nextBoundary time.Time, specChanged bool) driftDecision {
+	if previous == nil || previous.ObservedReplicas == nil || desired.equal(current) {
+		return driftDecision{}
+	}
+
+	// Drift accepted by an earlier reconcile is kept until its boundary while the policy still allows it
+	if previous.DriftAcceptedUntil != nil && now.Before(previous.DriftAcceptedUntil.Time) &&
+		!specChanged && allowsDrift(policy, current, desired) {
+		return driftDecision{
+			keep:          true,
+			acceptedUntil: previous.DriftAcceptedUntil,
+			message: fmt.Sprintf("%s kept at %s replicas until %s",
+				target, current, previous.DriftAcceptedUntil.Format(time.RFC3339)),
+		}
+	}
+
+	// With Ignore, only changes of the computed replicas are applied
+	if policy == kyklosv1alpha1.DriftPolicyIgnore &&
+		previous.EffectiveReplicas != nil && *previous.EffectiveReplicas == desired.Replicas {
+		return driftDecision{
+			keep:     true,
+			detected: current.Replicas != *previous.ObservedReplicas,
+			message:  fmt.Sprintf("%s kept at %s replicas, expected %s", target, current, desired),
+		}
+	}
+
+	if current.Replicas == *previous.ObservedReplicas {
+		return driftDecision{}
+	}
+
+	decision := driftDecision{detected: true}
+	scaledUp := current.Replicas > *previous.ObservedReplicas && current.Replicas > desired.Replicas
+	switch {
+	case policy == kyklosv1alpha1.DriftPolicyAllowUntilNextBoundary,
+		policy == kyklosv1alpha1.DriftPolicyAllowUpOnly && scaledUp:
+		until := metav1.NewTime(nextBoundary)
+		decision.keep = true
+		decision.acceptedUntil = &until
+		decision.message = fmt.Sprintf("%s manually scaled from %d to %s, kept until %s",
+			target, *previous.ObservedReplicas, current, nextBoundary.Format(time.RFC3339))
+	case policy == kyklosv1alpha1.DriftPolicyIgnore:
+		decision.message = fmt.Sprintf("%s manually scaled from %d to %s, applying schedule change to %s",
+			target, *previous.ObservedReplicas, current, desired)
+	default:
+		decision.message = fmt.Sprintf("%s manually scaled from %d to %s, reverting to %s",
+			target, *previous.ObservedReplicas, current, desired)
+	}
+	return decision
+}
+
+// allowsDrift reports whether a policy keeps a target at current replicas until the next boundary
+func allowsDrift(policy string, current, desired *targetReplicas) bool {
+	switch policy {
+	case kyklosv1alpha1.DriftPolicyAllowUntilNextBoundary:
+		return true
+	case kyklosv1alpha1.DriftPolicyAllowUpOnly:
+		return current.Replicas > desired.Replicas
+	}
+	return false
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+)
+
+func TestEvaluateDrift(t *testing.T) {
+	now := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
+	nextBoundary := time.Date(2025, 3, 10, 17, 0, 0, 0, time.UTC)
+	acceptedUntil := metav1.NewTime(nextBoundary)
+	target := &targetWorkload{
+		GroupVersionKind: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		Name:             "web",
+		Namespace:        "default",
+	}
+
+	tests := []struct {
+		name             string
+		policy           string
+		observed         int32  // replicas recorded by the last reconcile
+		effective        *int32 // computed replicas recorded by the last reconcile
+		acceptedUntil    *metav1.Time
+		specChanged      bool
+		current          int32
+		desired          int32
+		wantKeep         bool
+		wantDetected     bool
+		wantAcceptedTill *time.Time
+	}{
+		{
+			name:     "No drift",
+			policy:   kyklosv1alpha1.DriftPolicyRevert,
+			observed: 3,
+			current:  3,
+			desired:  3,
+		},
+		{
+			name:         "Revert reverts a manual scale-up",
+			policy:       kyklosv1alpha1.DriftPolicyRevert,
+			observed:     3,
+			current:      8,
+			desired:      3,
+			wantDetected: true,
+		},
+		{
+			name:             "AllowUntilNextBoundary keeps a manual scale-down",
+			policy:           kyklosv1alpha1.DriftPolicyAllowUntilNextBoundary,
+			observed:         3,
+			current:          1,
+			desired:          3,
+			wantKeep:         true,
+			wantDetected:     true,
+			wantAcceptedTill: &nextBoundary,
+		},
+		{
+			name:          "AllowUntilNextBoundary keeps accepted drift",
+			policy:        kyklosv1alpha1.DriftPolicyAllowUntilNextBoundary,
+			observed:      1,
+			acceptedUntil: &acceptedUntil,
+			current:       1,
+			desired:       3,
+			wantKeep:      true,
+		},
+		{
+			name:             "AllowUpOnly keeps a manual scale-up",
+			policy:           kyklosv1alpha1.DriftPolicyAllowUpOnly,
+			observed:         3,
+			current:          8,
+			desired:          3,
+			wantKeep:         true,
+			wantDetected:     true,
+			wantAcceptedTill: &nextBoundary,
+		},
+		{
+			name:         "AllowUpOnly reverts a manual scale-down",
+			policy:       kyklosv1alpha1.DriftPolicyAllowUpOnly,
+			observed:     3,
+			current:      1,
+			desired:      3,
+			wantDetected: true,
+		},
+		{
+			name:          "AllowUpOnly keeps a further scale-up after accepting one",
+			policy:        kyklosv1alpha1.DriftPolicyAllowUpOnly,
+			observed:      8,
+			acceptedUntil: &acceptedUntil,
+			current:       10,
+			desired:       3,
+			wantKeep:      true,
+		},
+		{
+			name:          "AllowUpOnly reverts a scale-down after accepting a scale-up",
+			policy:        kyklosv1alpha1.DriftPolicyAllowUpOnly,
+			observed:      8,
+			acceptedUntil: &acceptedUntil,
+			current:       0,
+			desired:       3,
+			wantDetected:  true,
+		},
+		{
+			name:          "Accepted drift expires at its boundary",
+			policy:        kyklosv1alpha1.DriftPolicyAllowUntilNextBoundary,
+			observed:      8,
+			acceptedUntil: &metav1.Time{Time: now},
+			current:       8,
+			desired:       3,
+		},
+		{
+			name:          "Switching to Revert drops accepted drift",
+			policy:        kyklosv1alpha1.DriftPolicyRevert,
+			observed:      8,
+			acceptedUntil: &acceptedUntil,
+			current:       8,
+			desired:       3,
+		},
+		{
+			name:          "A spec change such as a new override drops accepted drift",
+			policy:        kyklosv1alpha1.DriftPolicyAllowUntilNextBoundary,
+			observed:      8,
+			acceptedUntil: &acceptedUntil,
+			specChanged:   true,
+			current:       8,
+			desired:       5,
+		},
+		{
+			name:         "Ignore keeps a manual change while the computed replicas are unchanged",
+			policy:       kyklosv1alpha1.DriftPolicyIgnore,
+			observed:     3,
+			effective:    ptr(3),
+			current:      8,
+			desired:      3,
+			wantKeep:     true,
+			wantDetected: true,
+		},
+		{
+			name:      "Ignore keeps an earlier manual change",
+			policy:    kyklosv1alpha1.DriftPolicyIgnore,
+			observed:  8,
+			effective: ptr(3),
+			current:   8,
+			desired:   3,
+			wantKeep:  true,
+		},
+		{
+			name:      "Ignore applies a change of the computed replicas",
+			policy:    kyklosv1alpha1.DriftPolicyIgnore,
+			observed:  8,
+			effective: ptr(3),
+			current:   8,
+			desired:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous := &kyklosv1alpha1.TargetStatus{
+				ObservedReplicas:   &tt.observed,
+				EffectiveReplicas:  tt.effective,
+				DriftAcceptedUntil: tt.acceptedUntil,
+			}
+			decision := evaluateDrift(tt.policy, previous, target,
+				&targetReplicas{Replicas: tt.current}, &targetReplicas{Replicas: tt.desired},
+				now, nextBoundary, tt.specChanged)
+
+			if decision.keep != tt.wantKeep {
+				t.Errorf("keep = %v, want %v (%s)", decision.keep, tt.wantKeep, decision.message)
+			}
+			if decision.detected != tt.wantDetected {
+				t.Errorf("detected = %v, want %v (%s)", decision.detected, tt.wantDetected, decision.message)
+			}
+			if tt.wantAcceptedTill != nil {
+				if decision.acceptedUntil == nil || !decision.acceptedUntil.Time.Equal(*tt.wantAcceptedTill) {
+					t.Errorf("acceptedUntil = %v, want %v", decision.acceptedUntil, *tt.wantAcceptedTill)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,364 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+)
+
+// targetWorkload is a TargetRef resolved through the REST mapper
+type targetWorkload struct {
+	GroupVersionKind schema.GroupVersionKind
+	Resource         schema.GroupVersionResource
+	Name             string
+	Namespace        string
+}
+
+// String returns a human readable reference such as "Deployment default/web"
+func (t *targetWorkload) String() string {
+	return fmt.Sprintf("%s %s/%s", t.GroupVersionKind.Kind, t.Namespace, t.Name)
+}
+
+// isHorizontalPodAutoscaler reports whether the target is an HPA whose bounds are scaled
+func (t *targetWorkload) isHorizontalPodAutoscaler() bool {
+	return t.GroupVersionKind.Group == autoscalingv2.GroupName &&
+		t.GroupVersionKind.Kind == kyklosv1alpha1.TargetKindHorizontalPodAutoscaler
+}
+
+// keepsOriginalAnnotations reports whether the pre-management replicas are also recorded in annotations
+// on the target. Only watched kinds are annotated; other kinds only grant access to the scale
+// subresource, so their original replicas are kept in status alone.
+func (t *targetWorkload) keepsOriginalAnnotations() bool {
+	return t.isWatchedKind()
+}
+
+// isWatchedKind reports whether the manager watches the target kind and may list and patch it.
+// Reading any other kind through the cached client would start an informer it has no RBAC for.
+func (t *targetWorkload) isWatchedKind() bool {
+	switch {
+	case t.isHorizontalPodAutoscaler():
+		return true
+	case t.GroupVersionKind.Group == appsv1.GroupName:
+		return t.GroupVersionKind.Kind == kyklosv1alpha1.TargetKindDeployment ||
+			t.GroupVersionKind.Kind == kyklosv1alpha1.TargetKindStatefulSet
+	}
+	return false
+}
+
+// object returns an unstructured stub identifying the workload
+func (t *targetWorkload) object() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(t.GroupVersionKind)
+	obj.SetName(t.Name)
+	obj.SetNamespace(t.Namespace)
+	return obj
+}
+
+// targetKindOf returns the kind referenced by a TargetRef, defaulting to Deployment
+func targetKindOf(ref kyklosv1alpha1.TargetRef) string {
+	if ref.Kind == "" {
+		return kyklosv1alpha1.TargetKindDeployment
+	}
+	return ref.Kind
+}
+
+// targetGroupVersionOf returns the group/version referenced by a TargetRef, defaulting to apps/v1
+func targetGroupVersionOf(ref kyklosv1alpha1.TargetRef) (schema.GroupVersion, error) {
+	if ref.APIVersion == "" {
+		return schema.GroupVersion{Group: "apps", Version: "v1"}, nil
+	}
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return schema.GroupVersion{}, fmt.Errorf("invalid target apiVersion %q: %w", ref.APIVersion, err)
+	}
+	return gv, nil
+}
+
+// resolveTargetWorkload maps a TargetRef onto a concrete resource using the client's REST mapper
+func resolveTargetWorkload(c client.Client, ref kyklosv1alpha1.TargetRef, defaultNamespace string) (*targetWorkload, error) {
+	gv, err := targetGroupVersionOf(ref)
+	if err != nil {
+		return nil, err
+	}
+	gk := schema.GroupKind{Group: gv.Group, Kind: targetKindOf(ref)}
+
+	mapping, err := c.RESTMapper().RESTMapping(gk, gv.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve target kind %s: %w", gk.WithVersion(gv.Version), err)
+	}
+
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	return &targetWorkload{
+		GroupVersionKind: mapping.GroupVersionKind,
+		Resource:         mapping.Resource,
+		Name:             ref.Name,
+		Namespace:        namespace,
+	}, nil
+}
+
+// selectTargetWorkloads lists the workloads matching a TargetSelector, sorted by namespace and name.
+// Without a namespaceSelector only defaultNamespace is searched. Namespaces and workloads carrying
+// the opt-out annotation are skipped.
+func selectTargetWorkloads(ctx context.Context, c client.Client, sel *kyklosv1alpha1.TargetSelector, defaultNamespace string) ([]*targetWorkload, error) {
+	kind, err := resolveTargetWorkload(c, kyklosv1alpha1.TargetRef{APIVersion: sel.APIVersion, Kind: sel.Kind}, defaultNamespace)
+	if err != nil {
+		return nil, err
+	}
+	if !kind.isWatchedKind() {
+		return nil, fmt.Errorf("selector kind %s is not supported; reference it through targetRef instead",
+			kind.GroupVersionKind.GroupKind())
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&sel.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target selector: %w", err)
+	}
+
+	namespaces := []string{defaultNamespace}
+	if sel.NamespaceSelector != nil {
+		nsSelector, err := metav1.LabelSelectorAsSelector(sel.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace selector: %w", err)
+		}
+		nsList := &corev1.NamespaceList{}
+		if err := c.List(ctx, nsList, client.MatchingLabelsSelector{Selector: nsSelector}); err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+		namespaces = namespaces[:0]
+		for _, ns := range nsList.Items {
+			if optedOut(&ns) {
+				continue
+			}
+			namespaces = append(namespaces, ns.Name)
+		}
+	}
+
+	var targets []*targetWorkload
+	for _, namespace := range namespaces {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(kind.GroupVersionKind.GroupVersion().WithKind(kind.GroupVersionKind.Kind + "List"))
+		if err := c.List(ctx, list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, fmt.Errorf("failed to list %s in namespace %s: %w", kind.Resource.Resource, namespace, err)
+		}
+		for _, item := range list.Items {
+			if optedOut(&item) {
+				continue
+			}
+			targets = append(targets, &targetWorkload{
+				GroupVersionKind: kind.GroupVersionKind,
+				Resource:         kind.Resource,
+				Name:             item.Name,
+				Namespace:        item.Namespace,
+			})
+		}
+	}
+
+	sort.Slice(targets, func(i, j int) bool {
+		if targets[i].Namespace != targets[j].Namespace {
+			return targets[i].Namespace < targets[j].Namespace
+		}
+		return targets[i].Name < targets[j].Name
+	})
+	return targets, nil
+}
+
+// optedOut reports whether an object carries the opt-out annotation
+func optedOut(obj metav1.Object) bool {
+	return obj.GetAnnotations()[kyklosv1alpha1.OptOutAnnotation] == "true"
+}
+
+// getScale reads the scale subresource of a workload
+func getScale(ctx context.Context, c client.Client, target *targetWorkload) (*autoscalingv1.Scale, error) {
+	scale := &autoscalingv1.Scale{}
+	if err := c.SubResource("scale").Get(ctx, target.object(), scale); err != nil {
+		return nil, err
+	}
+	return scale, nil
+}
+
+// updateScale writes a new replica count through the scale subresource
+func updateScale(ctx context.Context, c client.Client, target *targetWorkload, scale *autoscalingv1.Scale, replicas int32) error {
+	scale.Spec.Replicas = replicas
+	return c.SubResource("scale").Update(ctx, target.object(), client.WithSubResourceBody(scale))
+}
+
+// targetReplicas is the replica state of a target: spec.replicas for scalable workloads,
+// or the minReplicas/maxReplicas pair for HorizontalPodAutoscalers
+type targetReplicas struct {
+	Replicas    int32
+	MaxReplicas *int32
+
+	scale *autoscalingv1.Scale
+	hpa   *autoscalingv2.HorizontalPodAutoscaler
+}
+
+// String formats the replica state for events and messages
+func (s *targetReplicas) String() string {
+	if s.hpa != nil {
+		return fmt.Sprintf("min=%d max=%d", s.Replicas, *s.MaxReplicas)
+	}
+	return fmt.Sprintf("%d", s.Replicas)
+}
+
+// withBounds returns the state the target should have for the computed replicas.
+// HPAs cannot go below one replica and maxReplicas is never lowered below minReplicas.
+func (s *targetReplicas) withBounds(replicas int32, maxReplicas *int32) *targetReplicas {
+	desired := *s
+	desired.Replicas = replicas
+	if s.hpa == nil {
+		return &desired
+	}
+	if desired.Replicas < 1 {
+		desired.Replicas = 1
+	}
+	upper := *s.MaxReplicas
+	if maxReplicas != nil {
+		upper = *maxReplicas
+	}
+	if upper < desired.Replicas {
+		upper = desired.Replicas
+	}
+	desired.MaxReplicas = &upper
+	return &desired
+}
+
+// equal reports whether two replica states would leave the target unchanged
+func (s *targetReplicas) equal(other *targetReplicas) bool {
+	if s.Replicas != other.Replicas {
+		return false
+	}
+	if s.MaxReplicas == nil || other.MaxReplicas == nil {
+		return s.MaxReplicas == other.MaxReplicas
+	}
+	return *s.MaxReplicas == *other.MaxReplicas
+}
+
+// readTargetReplicas reads the current replica state of a target
+func readTargetReplicas(ctx context.Context, c client.Client, target *targetWorkload) (*targetReplicas, error) {
+	if target.isHorizontalPodAutoscaler() {
+		hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+		if err := c.Get(ctx, client.ObjectKey{Name: target.Name, Namespace: target.Namespace}, hpa); err != nil {
+			return nil, err
+		}
+		minReplicas := int32(1)
+		if hpa.Spec.MinReplicas != nil {
+			minReplicas = *hpa.Spec.MinReplicas
+		}
+		maxReplicas := hpa.Spec.MaxReplicas
+		return &targetReplicas{Replicas: minReplicas, MaxReplicas: &maxReplicas, hpa: hpa}, nil
+	}
+
+	scale, err := getScale(ctx, c, target)
+	if err != nil {
+		return nil, err
+	}
+	return &targetReplicas{Replicas: scale.Spec.Replicas, scale: scale}, nil
+}
+
+// writeTargetReplicas applies a desired replica state obtained from withBounds
+func writeTargetReplicas(ctx context.Context, c client.Client, target *targetWorkload, desired *targetReplicas) error {
+	if desired.hpa != nil {
+		base := desired.hpa.DeepCopy()
+		minReplicas := desired.Replicas
+		desired.hpa.Spec.MinReplicas = &minReplicas
+		desired.hpa.Spec.MaxReplicas = *desired.MaxReplicas
+		return c.Patch(ctx, desired.hpa, client.MergeFrom(base))
+	}
+	return updateScale(ctx, c, target, desired.scale, desired.Replicas)
+}
+
+// readOriginalReplicas returns the pre-management replicas recorded in a target's annotations, if any
+func readOriginalReplicas(ctx context.Context, c client.Client, target *targetWorkload) (replicas, maxReplicas *int32, err error) {
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(target.GroupVersionKind)
+	if err := c.Get(ctx, client.ObjectKey{Name: target.Name, Namespace: target.Namespace}, obj); err != nil {
+		return nil, nil, err
+	}
+	if replicas, err = parseReplicasAnnotation(obj, kyklosv1alpha1.OriginalReplicasAnnotation); err != nil {
+		return nil, nil, err
+	}
+	if maxReplicas, err = parseReplicasAnnotation(obj, kyklosv1alpha1.OriginalMaxReplicasAnnotation); err != nil {
+		return nil, nil, err
+	}
+	return replicas, maxReplicas, nil
+}
+
+// parseReplicasAnnotation parses an int32 annotation, returning nil when it is absent
+func parseReplicasAnnotation(obj metav1.Object, key string) (*int32, error) {
+	value, ok := obj.GetAnnotations()[key]
+	if !ok {
+		return nil, nil
+	}
+	parsed, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation %q: %w", key, value, err)
+	}
+	replicas := int32(parsed)
+	return &replicas, nil
+}
+
+// recordOriginalReplicas annotates a target with the replicas it had before it was first managed
+func recordOriginalReplicas(ctx context.Context, c client.Client, target *targetWorkload, original *targetReplicas) error {
+	annotations := map[string]any{
+		kyklosv1alpha1.OriginalReplicasAnnotation: strconv.Itoa(int(original.Replicas)),
+	}
+	if original.MaxReplicas != nil {
+		annotations[kyklosv1alpha1.OriginalMaxReplicasAnnotation] = strconv.Itoa(int(*original.MaxReplicas))
+	}
+	return patchAnnotations(ctx, c, target, annotations)
+}
+
+// clearOriginalReplicas removes the original replicas annotations from a target
+func clearOriginalReplicas(ctx context.Context, c client.Client, target *targetWorkload) error {
+	return patchAnnotations(ctx, c, target, map[string]any{
+		kyklosv1alpha1.OriginalReplicasAnnotation:    nil,
+		kyklosv1alpha1.OriginalMaxReplicasAnnotation: nil,
+	})
+}
+
+// patchAnnotations merge-patches annotations onto a target; nil values remove the annotation
+func patchAnnotations(ctx context.Context, c client.Client, target *targetWorkload, annotations map[string]any) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return c.Patch(ctx, target.object(), client.RawPatch(types.MergePatchType, patch))
+}
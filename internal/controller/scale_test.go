@@ -0,0 +1,105 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+)
+
+var _ = Describe("Original replicas", func() {
+	It("Should keep the original replicas of a non-apps target in status only", func() {
+		ctx := context.Background()
+
+		// Only the scale subresource of arbitrary kinds is accessible, so any metadata access fails
+		var metadataCalls int
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					metadataCalls++
+					return fmt.Errorf("get %s is forbidden", key)
+				},
+				Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					metadataCalls++
+					return fmt.Errorf("patch %s is forbidden", obj.GetName())
+				},
+			}).
+			Build()
+		reconciler := &TimeWindowScalerReconciler{Client: fakeClient}
+
+		tws := &kyklosv1alpha1.TimeWindowScaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "rollout-tws", Namespace: "apps"},
+		}
+		rollout := &targetWorkload{
+			GroupVersionKind: schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"},
+			Resource:         schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"},
+			Name:             "web",
+			Namespace:        "apps",
+		}
+		Expect(rollout.keepsOriginalAnnotations()).To(BeFalse())
+
+		replicas, maxReplicas := reconciler.ensureOriginalReplicas(ctx, tws, rollout, &targetReplicas{Replicas: 3})
+		Expect(replicas).NotTo(BeNil())
+		Expect(*replicas).To(Equal(int32(3)))
+		Expect(maxReplicas).To(BeNil())
+		Expect(metadataCalls).To(BeZero())
+
+		// Later reconciles use the status entry
+		tws.Status.Targets = []kyklosv1alpha1.TargetStatus{
+			{Kind: "Rollout", Name: "web", Namespace: "apps", OriginalReplicas: replicas},
+		}
+		replicas, _ = reconciler.originalReplicas(ctx, tws, rollout)
+		Expect(*replicas).To(Equal(int32(3)))
+		Expect(metadataCalls).To(BeZero())
+	})
+
+	It("Should annotate Deployments, StatefulSets and HorizontalPodAutoscalers", func() {
+		for _, gvk := range []schema.GroupVersionKind{
+			{Group: "apps", Version: "v1", Kind: kyklosv1alpha1.TargetKindDeployment},
+			{Group: "apps", Version: "v1", Kind: kyklosv1alpha1.TargetKindStatefulSet},
+			{Group: "autoscaling", Version: "v2", Kind: kyklosv1alpha1.TargetKindHorizontalPodAutoscaler},
+		} {
+			Expect((&targetWorkload{GroupVersionKind: gvk}).keepsOriginalAnnotations()).To(BeTrue(), gvk.String())
+		}
+		Expect((&targetWorkload{GroupVersionKind: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}}).
+			keepsOriginalAnnotations()).To(BeFalse())
+	})
+})
+
+// testRESTMapper maps the workload kinds used by fake-client tests
+func testRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for _, kind := range []string{"Deployment", "StatefulSet", "ReplicaSet"} {
+		mapper.Add(appsv1.SchemeGroupVersion.WithKind(kind), meta.RESTScopeNamespace)
+	}
+	mapper.Add(autoscalingv2.SchemeGroupVersion.WithKind(kyklosv1alpha1.TargetKindHorizontalPodAutoscaler), meta.RESTScopeNamespace)
+	return mapper
+}
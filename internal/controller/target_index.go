@@ -0,0 +1,141 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+)
+
+const (
+	// targetRefIndexKey indexes TimeWindowScalers by the workload in spec.targetRef
+	targetRefIndexKey = "spec.targetRef"
+	// targetSelectorKindIndexKey indexes TimeWindowScalers by the kind selected by spec.selector
+	targetSelectorKindIndexKey = "spec.selector.kind"
+)
+
+// targetChangedPredicate passes workload changes to their spec (e.g. manual scaling) and to the labels and
+// annotations that select them
+var targetChangedPredicate = predicate.Or(
+	predicate.GenerationChangedPredicate{},
+	predicate.LabelChangedPredicate{},
+	predicate.AnnotationChangedPredicate{},
+)
+
+// targetRefIndexValue formats the index value of a workload as "Kind/namespace/name"
+func targetRefIndexValue(kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+// indexTargetRef extracts the spec.targetRef index value of a TimeWindowScaler
+func indexTargetRef(obj client.Object) []string {
+	tws, ok := obj.(*kyklosv1alpha1.TimeWindowScaler)
+	if !ok || tws.Spec.Selector != nil || tws.Spec.TargetRef.Name == "" {
+		return nil
+	}
+	namespace := tws.Spec.TargetRef.Namespace
+	if namespace == "" {
+		namespace = tws.Namespace
+	}
+	return []string{targetRefIndexValue(targetKindOf(tws.Spec.TargetRef), namespace, tws.Spec.TargetRef.Name)}
+}
+
+// indexTargetSelectorKind extracts the spec.selector.kind index value of a TimeWindowScaler
+func indexTargetSelectorKind(obj client.Object) []string {
+	tws, ok := obj.(*kyklosv1alpha1.TimeWindowScaler)
+	if !ok || tws.Spec.Selector == nil {
+		return nil
+	}
+	return []string{targetKindOf(kyklosv1alpha1.TargetRef{Kind: tws.Spec.Selector.Kind})}
+}
+
+// findTimeWindowScalersForTarget returns a map function enqueueing the TimeWindowScalers that scale
+// a workload of the given kind, either through spec.targetRef or through a matching spec.selector
+func (r *TimeWindowScalerReconciler) findTimeWindowScalersForTarget(kind string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		logger := log.FromContext(ctx)
+
+		var requests []reconcile.Request
+		enqueue := func(tws *kyklosv1alpha1.TimeWindowScaler) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      tws.Name,
+					Namespace: tws.Namespace,
+				},
+			})
+		}
+
+		// Scalers referencing the workload by name
+		twsList := &kyklosv1alpha1.TimeWindowScalerList{}
+		if err := r.List(ctx, twsList, client.MatchingFields{
+			targetRefIndexKey: targetRefIndexValue(kind, obj.GetNamespace(), obj.GetName()),
+		}); err != nil {
+			logger.Error(err, "Failed to list TimeWindowScalers for target change",
+				"kind", kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+			return nil
+		}
+		for i := range twsList.Items {
+			enqueue(&twsList.Items[i])
+		}
+
+		// Scalers selecting the workload by label
+		selectorList := &kyklosv1alpha1.TimeWindowScalerList{}
+		if err := r.List(ctx, selectorList, client.MatchingFields{targetSelectorKindIndexKey: kind}); err != nil {
+			logger.Error(err, "Failed to list TimeWindowScalers for target change",
+				"kind", kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+			return requests
+		}
+		var namespace *corev1.Namespace
+		for i := range selectorList.Items {
+			tws := &selectorList.Items[i]
+			selector, err := metav1.LabelSelectorAsSelector(&tws.Spec.Selector.LabelSelector)
+			if err != nil || !selector.Matches(labels.Set(obj.GetLabels())) {
+				continue
+			}
+			if tws.Spec.Selector.NamespaceSelector == nil {
+				if tws.Namespace == obj.GetNamespace() {
+					enqueue(tws)
+				}
+				continue
+			}
+			if namespace == nil {
+				namespace = &corev1.Namespace{}
+				if err := r.Get(ctx, client.ObjectKey{Name: obj.GetNamespace()}, namespace); err != nil {
+					logger.Error(err, "Failed to get namespace for target change", "namespace", obj.GetNamespace())
+					return requests
+				}
+			}
+			nsSelector, err := metav1.LabelSelectorAsSelector(tws.Spec.Selector.NamespaceSelector)
+			if err == nil && nsSelector.Matches(labels.Set(namespace.Labels)) {
+				enqueue(tws)
+			}
+		}
+		return requests
+	}
+}
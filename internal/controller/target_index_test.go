@@ -0,0 +1,101 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+)
+
+var _ = Describe("Target watch mapping", func() {
+	It("Should map a workload back to the scalers referencing or selecting it", func() {
+		ctx := context.Background()
+
+		byRef := &kyklosv1alpha1.TimeWindowScaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "by-ref", Namespace: "apps"},
+			Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+				TargetRef: kyklosv1alpha1.TargetRef{Kind: "Deployment", Name: "web"},
+			},
+		}
+		otherRef := &kyklosv1alpha1.TimeWindowScaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-ref", Namespace: "apps"},
+			Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+				TargetRef: kyklosv1alpha1.TargetRef{Kind: "StatefulSet", Name: "web"},
+			},
+		}
+		bySelector := &kyklosv1alpha1.TimeWindowScaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "by-selector", Namespace: "apps"},
+			Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+				Selector: &kyklosv1alpha1.TargetSelector{
+					LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}},
+				},
+			},
+		}
+		byNamespaceSelector := &kyklosv1alpha1.TimeWindowScaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "by-namespace-selector", Namespace: "platform"},
+			Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+				Selector: &kyklosv1alpha1.TargetSelector{
+					LabelSelector:     metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}},
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+				},
+			},
+		}
+		otherNamespace := &kyklosv1alpha1.TimeWindowScaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-namespace", Namespace: "platform"},
+			Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+				Selector: &kyklosv1alpha1.TargetSelector{
+					LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}},
+				},
+			},
+		}
+
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithObjects(
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "apps", Labels: map[string]string{"env": "dev"}}},
+				byRef, otherRef, bySelector, byNamespaceSelector, otherNamespace,
+			).
+			WithIndex(&kyklosv1alpha1.TimeWindowScaler{}, targetRefIndexKey, indexTargetRef).
+			WithIndex(&kyklosv1alpha1.TimeWindowScaler{}, targetSelectorKindIndexKey, indexTargetSelectorKind).
+			Build()
+		reconciler := &TimeWindowScalerReconciler{Client: fakeClient}
+
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "web",
+				Namespace: "apps",
+				Labels:    map[string]string{"tier": "web"},
+			},
+		}
+		requests := reconciler.findTimeWindowScalersForTarget(kyklosv1alpha1.TargetKindDeployment)(ctx, deployment)
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "by-ref", Namespace: "apps"}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "by-selector", Namespace: "apps"}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "by-namespace-selector", Namespace: "platform"}},
+		))
+	})
+})
@@ -18,10 +18,14 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -40,11 +44,19 @@ import (
 	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
 	"github.com/roguepikachu/kyklos/internal/engine"
 	"github.com/roguepikachu/kyklos/internal/metrics"
+	"github.com/roguepikachu/kyklos/internal/windowspec"
 )
 
 // Finalizer for cleaning up resources
 const timeWindowScalerFinalizer = "kyklos.kyklos.io/finalizer"
 
+const (
+	// upcomingTransitions is the number of scheduled transitions published in status.upcoming
+	upcomingTransitions = 5
+	// previewHorizon is how far ahead status.upcoming looks
+	previewHorizon = 7 * 24 * time.Hour
+)
+
 // TimeWindowScalerReconciler reconciles a TimeWindowScaler object
 type TimeWindowScalerReconciler struct {
 	client.Client
@@ -56,10 +68,16 @@ type TimeWindowScalerReconciler struct {
 // +kubebuilder:rbac:groups=kyklos.kyklos.io,resources=timewindowscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=kyklos.kyklos.io,resources=timewindowscalers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=kyklos.kyklos.io,resources=timewindowscalers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kyklos.kyklos.io,resources=timewindowschedules,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=apps,resources=deployments/scale,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets/scale,verbs=get;update;patch
+// +kubebuilder:rbac:groups=*,resources=*/scale,verbs=get;update;patch
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -115,36 +133,54 @@ func (r *TimeWindowScalerReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{Requeue: true}, nil
 	}
 
-	// Determine target namespace
-	targetNamespace := tws.Spec.TargetRef.Namespace
-	if targetNamespace == "" {
-		targetNamespace = tws.Namespace
-	}
-
-	// Fetch the target Deployment
-	deployment := &appsv1.Deployment{}
-	deploymentKey := types.NamespacedName{
-		Name:      tws.Spec.TargetRef.Name,
-		Namespace: targetNamespace,
+	// Resolve the target workloads through the REST mapper
+	var targets []*targetWorkload
+	targets, err = r.resolveTargets(ctx, tws)
+	if err != nil {
+		logger.Error(err, "Failed to resolve target workloads")
+		r.setErrorCondition(tws, "InvalidTarget", err.Error())
+		if statusErr := r.Status().Update(ctx, tws); statusErr != nil {
+			logger.Error(statusErr, "Failed to update status after target resolution error")
+		}
+		r.Recorder.Event(tws, corev1.EventTypeWarning, "InvalidTarget", err.Error())
+		return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
 	}
 
-	if err = r.Get(ctx, deploymentKey, deployment); err != nil {
-		if apierrors.IsNotFound(err) {
-			// Target not found - update status and requeue
-			return r.handleMissingTarget(ctx, tws)
-		}
-		logger.Error(err, "Failed to get target deployment",
-			"deployment", deploymentKey.Name,
-			"namespace", deploymentKey.Namespace)
-		// Set error condition
-		r.setErrorCondition(tws, "TargetFetchFailed",
-			fmt.Sprintf("Failed to get target deployment %s: %v", deploymentKey.Name, err))
-		if statusErr := r.Status().Update(ctx, tws); statusErr != nil {
-			logger.Error(statusErr, "Failed to update status after deployment fetch error")
+	// Fetch the current replica state of every target
+	currents := make([]*targetReplicas, 0, len(targets))
+	found := targets[:0]
+	for _, target := range targets {
+		var current *targetReplicas
+		if current, err = readTargetReplicas(ctx, r.Client, target); err != nil {
+			if apierrors.IsNotFound(err) && tws.Spec.Selector != nil {
+				// Selected workload deleted since it was listed
+				logger.Info("Selected target no longer exists", "target", target.String())
+				err = nil
+				continue
+			}
+			if apierrors.IsNotFound(err) {
+				// Target not found - update status and requeue
+				return r.handleMissingTarget(ctx, tws,
+					fmt.Sprintf("Target %s not found or does not expose the scale subresource", target))
+			}
+			logger.Error(err, "Failed to get target scale",
+				"target", target.String())
+			// Set error condition
+			r.setErrorCondition(tws, "TargetFetchFailed",
+				fmt.Sprintf("Failed to get scale of %s: %v", target, err))
+			if statusErr := r.Status().Update(ctx, tws); statusErr != nil {
+				logger.Error(statusErr, "Failed to update status after target fetch error")
+			}
+			r.Recorder.Event(tws, corev1.EventTypeWarning, "TargetFetchFailed",
+				fmt.Sprintf("Failed to get scale of %s: %v", target, err))
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 		}
-		r.Recorder.Event(tws, corev1.EventTypeWarning, "TargetFetchFailed",
-			fmt.Sprintf("Failed to get target deployment %s: %v", deploymentKey.Name, err))
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		currents = append(currents, current)
+		found = append(found, target)
+	}
+	targets = found
+	if len(targets) == 0 {
+		return r.handleMissingTarget(ctx, tws, "No workloads match the target selector")
 	}
 
 	// Check if paused - compute but don't apply
@@ -152,13 +188,29 @@ func (r *TimeWindowScalerReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		logger.Info("TimeWindowScaler is paused",
 			"name", tws.Name,
 			"namespace", tws.Namespace)
-		// Still compute to show what would happen
-		return r.computeAndUpdateStatus(ctx, tws, deployment)
+	}
+
+	// Resolve the schedule, either inline or from the referenced TimeWindowSchedule
+	var schedule *kyklosv1alpha1.TimeWindowScheduleSpec
+	schedule, err = r.resolveSchedule(ctx, tws)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			message := fmt.Sprintf("TimeWindowSchedule %s not found", tws.Spec.ScheduleRef.Name)
+			logger.Info("Referenced schedule not found", "schedule", tws.Spec.ScheduleRef.Name)
+			r.setErrorCondition(tws, "ScheduleNotFound", message)
+			if statusErr := r.Status().Update(ctx, tws); statusErr != nil {
+				logger.Error(statusErr, "Failed to update status after schedule lookup error")
+			}
+			r.Recorder.Event(tws, corev1.EventTypeWarning, "ScheduleNotFound", message)
+			return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+		}
+		logger.Error(err, "Failed to get TimeWindowSchedule")
+		return ctrl.Result{}, err
 	}
 
 	// Compute effective replicas using the engine
 	var engineInput engine.Input
-	engineInput, err = r.buildEngineInput(ctx, tws)
+	engineInput, err = r.buildEngineInput(ctx, tws, schedule)
 	if err != nil {
 		logger.Error(err, "Failed to build engine input")
 		r.setErrorCondition(tws, "InvalidConfiguration",
@@ -186,54 +238,43 @@ func (r *TimeWindowScalerReconciler) Reconcile(ctx context.Context, req ctrl.Req
 
 	// Log the decision
 	logger.Info("Computed scaling decision",
-		"nowLocal", engineInput.Now.In(mustLoadLocation(tws.Spec.Timezone)).Format(time.RFC3339),
+		"nowLocal", engineInput.Now.In(mustLoadLocation(schedule.Timezone)).Format(time.RFC3339),
 		"nextBoundary", engineOutput.NextBoundary.Format(time.RFC3339),
 		"effectiveReplicas", engineOutput.EffectiveReplicas,
 		"currentWindow", engineOutput.CurrentWindow,
 		"reason", engineOutput.Reason)
 
-	// Compare with current state
-	currentReplicas := *deployment.Spec.Replicas
-	targetReplicas := engineOutput.EffectiveReplicas
-
-	// Scale if needed
-	if currentReplicas != targetReplicas && !tws.Spec.Pause {
-		if err = r.scaleDeployment(ctx, deployment, targetReplicas); err != nil {
-			logger.Error(err, "Failed to scale deployment",
-				"deployment", deployment.Name,
-				"from", currentReplicas,
-				"to", targetReplicas)
-			r.setErrorCondition(tws, "ScaleFailed",
-				fmt.Sprintf("Failed to scale deployment from %d to %d: %v", currentReplicas, targetReplicas, err))
-			if statusErr := r.Status().Update(ctx, tws); statusErr != nil {
-				logger.Error(statusErr, "Failed to update status after scale error")
+	// Apply the decision to every target
+	targetStatuses := make([]kyklosv1alpha1.TargetStatus, 0, len(targets))
+	var scaleErrs []error
+	var driftMessages []string
+	nextBoundary := engineOutput.NextBoundary
+	graceActive := engineOutput.Reason == "grace-period-active"
+	for i, target := range targets {
+		targetOutput := engineOutput
+		if tws.Spec.Selector != nil {
+			// Grace periods are tracked per target against its last observed replicas
+			targetInput := engineInput
+			targetInput.CurrentReplicas = previousObservedReplicas(tws.Status.Targets, target)
+			if targetOutput, err = engine.ComputeEffectiveReplicas(targetInput); err != nil {
+				return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+			}
+			if targetOutput.NextBoundary.Before(nextBoundary) {
+				nextBoundary = targetOutput.NextBoundary
+			}
+			if targetOutput.Reason == "grace-period-active" {
+				graceActive = true
 			}
-			r.Recorder.Event(tws, corev1.EventTypeWarning, "ScaleFailed",
-				fmt.Sprintf("Failed to scale deployment from %d to %d: %v", currentReplicas, targetReplicas, err))
-			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 		}
 
-		// Emit event and track metrics
-		direction := "up"
-		eventType := "ScaledUp"
-		if targetReplicas < currentReplicas {
-			eventType = "ScaledDown"
-			direction = "down"
+		targetStatus, driftMessage, scaleErr := r.applyToTarget(ctx, tws, target, currents[i], targetOutput)
+		if scaleErr != nil {
+			scaleErrs = append(scaleErrs, scaleErr)
 		}
-		r.Recorder.Event(tws, corev1.EventTypeNormal, eventType,
-			fmt.Sprintf("Scaled from %d to %d replicas (window: %s)",
-				currentReplicas, targetReplicas, engineOutput.CurrentWindow))
-
-		// Track scale operation metric
-		metrics.ScaleOperationsTotal.WithLabelValues(
-			tws.Namespace,
-			tws.Name,
-			direction,
-			engineOutput.CurrentWindow,
-		).Inc()
-
-		// Update LastScaleTime when we actually scale
-		tws.Status.LastScaleTime = &metav1.Time{Time: r.Clock.Now()}
+		if driftMessage != "" {
+			driftMessages = append(driftMessages, driftMessage)
+		}
+		targetStatuses = append(targetStatuses, targetStatus)
 	}
 
 	// Update effective replicas gauge
@@ -241,7 +282,7 @@ func (r *TimeWindowScalerReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		tws.Namespace,
 		tws.Name,
 		engineOutput.CurrentWindow,
-	).Set(float64(targetReplicas))
+	).Set(float64(engineOutput.EffectiveReplicas))
 
 	// Track window transitions
 	previousWindow := tws.Status.CurrentWindow
@@ -254,16 +295,49 @@ func (r *TimeWindowScalerReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		).Inc()
 	}
 
+	// Report one-off windows that have ended; the engine has already validated the timezone
+	expiredWindows, _ := engine.ExpiredWindows(engineInput)
+	r.recordExpiredWindows(tws, expiredWindows)
+
+	// Emit events when a manual override starts or ends
+	if engineOutput.CurrentWindow == "Override" && previousWindow != "Override" {
+		r.Recorder.Event(tws, corev1.EventTypeNormal, "OverrideStarted",
+			fmt.Sprintf("Override pinning %d replicas until %s",
+				tws.Spec.Override.Replicas, tws.Spec.Override.Until.Format(time.RFC3339)))
+	} else if previousWindow == "Override" && engineOutput.CurrentWindow != "Override" {
+		r.Recorder.Event(tws, corev1.EventTypeNormal, "OverrideEnded",
+			fmt.Sprintf("Override ended, resuming schedule (window: %s)", engineOutput.CurrentWindow))
+	}
+
 	// Update status
 	tws.Status.ObservedGeneration = tws.Generation
 	tws.Status.EffectiveReplicas = &engineOutput.EffectiveReplicas
-	tws.Status.TargetObservedReplicas = deployment.Spec.Replicas
+	tws.Status.Targets = targetStatuses
+	if tws.Spec.Selector == nil {
+		desired := currents[0].withBounds(engineOutput.EffectiveReplicas, engineOutput.EffectiveMaxReplicas)
+		tws.Status.EffectiveMaxReplicas = desired.MaxReplicas
+		tws.Status.TargetObservedReplicas = targetStatuses[0].ObservedReplicas
+	} else {
+		tws.Status.EffectiveMaxReplicas = engineOutput.EffectiveMaxReplicas
+		tws.Status.TargetObservedReplicas = nil
+	}
 	tws.Status.CurrentWindow = engineOutput.CurrentWindow
-	nextBoundaryTime := metav1.NewTime(engineOutput.NextBoundary)
+	tws.Status.MatchedWindows = engineOutput.MatchedWindows
+	tws.Status.ExpiredWindows = expiredWindows
+	nextBoundaryTime := metav1.NewTime(nextBoundary)
 	tws.Status.NextBoundary = &nextBoundaryTime
 
+	// Publish the next scheduled transitions, with holidays ahead taken from the same ConfigMap
+	var isHoliday func(date time.Time) bool
+	if schedule.HolidayConfigMap != nil && *schedule.HolidayConfigMap != "" {
+		if calendar, _ := r.holidayCalendar(ctx, tws.Namespace, *schedule.HolidayConfigMap); calendar != nil {
+			isHoliday = calendar.IsHoliday
+		}
+	}
+	tws.Status.Upcoming = upcomingStatus(engineInput, isHoliday)
+
 	// Handle grace period expiry tracking
-	if engineOutput.Reason == "grace-period-active" && tws.Spec.GracePeriodSeconds != nil {
+	if graceActive && tws.Spec.GracePeriodSeconds != nil {
 		// Calculate and store grace period expiry time
 		if tws.Status.LastScaleTime != nil {
 			gracePeriodExpiry := tws.Status.LastScaleTime.Time.Add(time.Duration(*tws.Spec.GracePeriodSeconds) * time.Second)
@@ -295,16 +369,57 @@ func (r *TimeWindowScalerReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		Reason:             "Reconciled",
 		Message:            fmt.Sprintf("TimeWindowScaler is ready, window: %s", engineOutput.CurrentWindow),
 	}
+	if tws.Spec.Pause {
+		readyCondition.Reason = "Paused"
+		readyCondition.Message = "TimeWindowScaler is paused"
+	}
 
 	meta.SetStatusCondition(&tws.Status.Conditions, readyCondition)
 
+	// Set DriftDetected condition
+	driftCondition := metav1.Condition{
+		Type:               "DriftDetected",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: tws.Generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             "NoDrift",
+		Message:            "Targets match the schedule",
+	}
+	if len(driftMessages) > 0 {
+		driftCondition.Status = metav1.ConditionTrue
+		driftCondition.Reason = driftPolicyOf(tws)
+		driftCondition.Message = strings.Join(driftMessages, "; ")
+	}
+	meta.SetStatusCondition(&tws.Status.Conditions, driftCondition)
+
+	if len(scaleErrs) > 0 {
+		err = errors.Join(scaleErrs...)
+		r.setErrorCondition(tws, "ScaleFailed", err.Error())
+	}
+
 	// Update the status
-	if err = r.Status().Update(ctx, tws); err != nil {
-		return ctrl.Result{}, err
+	if statusErr := r.Status().Update(ctx, tws); statusErr != nil {
+		return ctrl.Result{}, statusErr
+	}
+	if err != nil {
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	}
+
+	// Remove ended one-off windows from the spec when requested
+	if tws.Spec.ExpiredWindowPolicy == kyklosv1alpha1.ExpiredWindowPolicyDelete && len(expiredWindows) > 0 {
+		if err = r.deleteExpiredWindows(ctx, tws, expiredWindows); err != nil {
+			if apierrors.IsConflict(err) {
+				// The windows changed since they were read; retry with the current ones
+				logger.Info("TWS changed while deleting expired one-off windows, requeuing")
+				return ctrl.Result{Requeue: true}, nil
+			}
+			logger.Error(err, "Failed to delete expired one-off windows")
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+		}
 	}
 
 	// Calculate requeue time - requeue just before next boundary
-	requeueAfter := engineOutput.NextBoundary.Sub(r.Clock.Now()) - 10*time.Second
+	requeueAfter := nextBoundary.Sub(r.Clock.Now()) - 10*time.Second
 	if requeueAfter < 30*time.Second {
 		requeueAfter = 30 * time.Second
 	}
@@ -312,75 +427,226 @@ func (r *TimeWindowScalerReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
-// buildEngineInput converts TWS spec to engine input
-func (r *TimeWindowScalerReconciler) buildEngineInput(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler) (engine.Input, error) {
+// applyToTarget scales one target to the computed replicas (unless paused or kept by the drift policy)
+// and returns its status entry along with a description of any drift
+func (r *TimeWindowScalerReconciler) applyToTarget(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler, target *targetWorkload, current *targetReplicas, engineOutput engine.Output) (kyklosv1alpha1.TargetStatus, string, error) {
+	logger := log.FromContext(ctx)
+
+	desired := current.withBounds(engineOutput.EffectiveReplicas, engineOutput.EffectiveMaxReplicas)
+	targetStatus := kyklosv1alpha1.TargetStatus{
+		Kind:              target.GroupVersionKind.Kind,
+		Name:              target.Name,
+		Namespace:         target.Namespace,
+		ObservedReplicas:  &current.Replicas,
+		EffectiveReplicas: &desired.Replicas,
+	}
+	targetStatus.OriginalReplicas, targetStatus.OriginalMaxReplicas = r.ensureOriginalReplicas(ctx, tws, target, current)
+
+	if tws.Spec.Pause {
+		return targetStatus, "", nil
+	}
+
+	// Evaluate manual changes since the last reconcile against the drift policy; a spec change
+	// (e.g. a new policy or override) since the last reconcile drops previously accepted drift
+	specChanged := tws.Generation != tws.Status.ObservedGeneration
+	drift := evaluateDrift(driftPolicyOf(tws), previousTargetStatus(tws.Status.Targets, target),
+		target, current, desired, r.Clock.Now(), engineOutput.NextBoundary, specChanged)
+	if drift.detected {
+		r.Recorder.Event(tws, corev1.EventTypeNormal, "DriftDetected", drift.message)
+	}
+	targetStatus.DriftAcceptedUntil = drift.acceptedUntil
+	if drift.keep {
+		desired = current
+	}
+
+	if desired.equal(current) {
+		return targetStatus, drift.message, nil
+	}
+
+	if err := writeTargetReplicas(ctx, r.Client, target, desired); err != nil {
+		logger.Error(err, "Failed to scale target",
+			"target", target.String(),
+			"from", current.String(),
+			"to", desired.String())
+		message := fmt.Sprintf("Failed to scale %s from %s to %s: %v", target, current, desired, err)
+		r.Recorder.Event(tws, corev1.EventTypeWarning, "ScaleFailed", message)
+		targetStatus.Message = message
+		return targetStatus, drift.message, fmt.Errorf("%s", message)
+	}
+
+	// Emit event and track metrics
+	direction := "up"
+	eventType := "ScaledUp"
+	if desired.Replicas < current.Replicas ||
+		(desired.Replicas == current.Replicas && desired.MaxReplicas != nil && *desired.MaxReplicas < *current.MaxReplicas) {
+		eventType = "ScaledDown"
+		direction = "down"
+	}
+	switch {
+	case target.isHorizontalPodAutoscaler():
+		r.Recorder.Event(tws, corev1.EventTypeNormal, eventType,
+			fmt.Sprintf("Set %s bounds from %s to %s (window: %s)",
+				target, current, desired, engineOutput.CurrentWindow))
+	case tws.Spec.Selector != nil:
+		r.Recorder.Event(tws, corev1.EventTypeNormal, eventType,
+			fmt.Sprintf("Scaled %s from %d to %d replicas (window: %s)",
+				target, current.Replicas, desired.Replicas, engineOutput.CurrentWindow))
+	default:
+		r.Recorder.Event(tws, corev1.EventTypeNormal, eventType,
+			fmt.Sprintf("Scaled from %d to %d replicas (window: %s)",
+				current.Replicas, desired.Replicas, engineOutput.CurrentWindow))
+	}
+
+	// Track scale operation metric
+	metrics.ScaleOperationsTotal.WithLabelValues(
+		tws.Namespace,
+		tws.Name,
+		direction,
+		engineOutput.CurrentWindow,
+	).Inc()
+
+	// Update LastScaleTime when we actually scale
+	tws.Status.LastScaleTime = &metav1.Time{Time: r.Clock.Now()}
+
+	targetStatus.ObservedReplicas = &desired.Replicas
+	return targetStatus, drift.message, nil
+}
+
+// resolveTargets returns the workloads a TimeWindowScaler scales
+func (r *TimeWindowScalerReconciler) resolveTargets(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler) ([]*targetWorkload, error) {
+	if tws.Spec.Selector != nil {
+		return selectTargetWorkloads(ctx, r.Client, tws.Spec.Selector, tws.Namespace)
+	}
+	target, err := resolveTargetWorkload(r.Client, tws.Spec.TargetRef, tws.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return []*targetWorkload{target}, nil
+}
+
+// previousTargetStatus returns the status entry recorded for a target by the last reconcile
+func previousTargetStatus(statuses []kyklosv1alpha1.TargetStatus, target *targetWorkload) *kyklosv1alpha1.TargetStatus {
+	for i := range statuses {
+		if statuses[i].Namespace == target.Namespace && statuses[i].Name == target.Name {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
+
+// previousObservedReplicas returns the replicas recorded for a target by the last reconcile
+func previousObservedReplicas(statuses []kyklosv1alpha1.TargetStatus, target *targetWorkload) int32 {
+	if ts := previousTargetStatus(statuses, target); ts != nil && ts.ObservedReplicas != nil {
+		return *ts.ObservedReplicas
+	}
+	return 0
+}
+
+// recordExpiredWindows emits an event for each one-off window that ended since the last reconcile
+func (r *TimeWindowScalerReconciler) recordExpiredWindows(tws *kyklosv1alpha1.TimeWindowScaler, expired []string) {
+	for _, name := range expired {
+		if !slices.Contains(tws.Status.ExpiredWindows, name) {
+			r.Recorder.Event(tws, corev1.EventTypeNormal, "WindowExpired",
+				fmt.Sprintf("One-off window %s has ended", name))
+		}
+	}
+}
+
+// deleteExpiredWindows removes ended one-off windows from the spec of a TWS. The patch fails with a conflict
+// when the TWS changed since it was read, so windows added meanwhile are not dropped.
+func (r *TimeWindowScalerReconciler) deleteExpiredWindows(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler, expired []string) error {
+	patch := client.MergeFromWithOptions(tws.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	kept := make([]kyklosv1alpha1.OneOffWindow, 0, len(tws.Spec.OneOffWindows))
+	for _, w := range tws.Spec.OneOffWindows {
+		if !slices.Contains(expired, w.Name) {
+			kept = append(kept, w)
+		}
+	}
+	tws.Spec.OneOffWindows = kept
+	if err := r.Patch(ctx, tws, patch); err != nil {
+		return err
+	}
+	r.Recorder.Event(tws, corev1.EventTypeNormal, "ExpiredWindowsDeleted",
+		fmt.Sprintf("Deleted ended one-off windows: %s", strings.Join(expired, ", ")))
+	return nil
+}
+
+// resolveSchedule returns the schedule fields of a TWS, fetching its TimeWindowSchedule when scheduleRef is set
+func (r *TimeWindowScalerReconciler) resolveSchedule(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler) (*kyklosv1alpha1.TimeWindowScheduleSpec, error) {
+	if tws.Spec.ScheduleRef == nil {
+		return &kyklosv1alpha1.TimeWindowScheduleSpec{
+			Timezone:         tws.Spec.Timezone,
+			DSTPolicy:        tws.Spec.DSTPolicy,
+			Windows:          tws.Spec.Windows,
+			HolidayMode:      tws.Spec.HolidayMode,
+			HolidayConfigMap: tws.Spec.HolidayConfigMap,
+		}, nil
+	}
+
+	schedule := &kyklosv1alpha1.TimeWindowSchedule{}
+	if err := r.Get(ctx, types.NamespacedName{Name: tws.Spec.ScheduleRef.Name, Namespace: tws.Namespace}, schedule); err != nil {
+		return nil, err
+	}
+	return &schedule.Spec, nil
+}
+
+// buildEngineInput converts TWS spec and its resolved schedule to engine input
+func (r *TimeWindowScalerReconciler) buildEngineInput(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler, schedule *kyklosv1alpha1.TimeWindowScheduleSpec) (engine.Input, error) {
 	logger := log.FromContext(ctx)
 
 	// Validate and convert windows
-	windows := make([]engine.WindowSpec, len(tws.Spec.Windows))
-	for i, w := range tws.Spec.Windows {
-		// Validate time format
-		if err := r.validateTimeFormat(w.Start); err != nil {
-			errMsg := fmt.Sprintf("Window '%s' has invalid start time '%s': %v", w.Name, w.Start, err)
-			logger.Error(err, errMsg)
-			r.Recorder.Event(tws, corev1.EventTypeWarning, "InvalidWindow",
-				errMsg)
-			return engine.Input{}, fmt.Errorf("%s", errMsg)
-		}
-		if err := r.validateTimeFormat(w.End); err != nil {
-			errMsg := fmt.Sprintf("Window '%s' has invalid end time '%s': %v", w.Name, w.End, err)
-			logger.Error(err, errMsg)
-			r.Recorder.Event(tws, corev1.EventTypeWarning, "InvalidWindow",
-				errMsg)
-			return engine.Input{}, fmt.Errorf("%s", errMsg)
-		}
-
-		// Validate replicas
-		if w.Replicas < 0 {
-			errMsg := fmt.Sprintf("Window '%s' has invalid replicas %d: must be >= 0", w.Name, w.Replicas)
-			logger.Error(nil, errMsg)
-			r.Recorder.Event(tws, corev1.EventTypeWarning, "InvalidWindow",
-				errMsg)
-			return engine.Input{}, fmt.Errorf("%s", errMsg)
-		}
-
-		windows[i] = engine.WindowSpec{
-			Start:    w.Start,
-			End:      w.End,
-			Replicas: w.Replicas,
-			Name:     w.Name,
-			Days:     w.Days,
-		}
-	}
-
-	// Check if today is a holiday
+	windows, err := windowspec.Convert(schedule.Windows, tws.Spec.OneOffWindows)
+	if err != nil {
+		logger.Error(err, "Invalid window")
+		r.Recorder.Event(tws, corev1.EventTypeWarning, "InvalidWindow", err.Error())
+		return engine.Input{}, err
+	}
+
+	// Check if today is a holiday, reporting its name in status
 	isHoliday := false
-	previousHolidayState := false // Track state changes for events
-	if tws.Spec.HolidayConfigMap != nil && *tws.Spec.HolidayConfigMap != "" {
-		holiday, err := r.checkHoliday(ctx, tws.Namespace, *tws.Spec.HolidayConfigMap, tws.Spec.Timezone)
+	holidayName := ""
+	if schedule.HolidayConfigMap != nil && *schedule.HolidayConfigMap != "" {
+		name, holiday, err := r.checkHoliday(ctx, tws.Namespace, *schedule.HolidayConfigMap, schedule.Timezone)
 		if err != nil {
-			// Log error and emit event - holidays are optional
-			log.FromContext(ctx).Error(err, "Failed to check holiday ConfigMap", "configmap", *tws.Spec.HolidayConfigMap)
+			// Log error and emit event - holidays are optional, and the valid keys of the ConfigMap still apply
+			log.FromContext(ctx).Error(err, "Failed to check holiday ConfigMap", "configmap", *schedule.HolidayConfigMap)
 			r.Recorder.Event(tws, corev1.EventTypeWarning, "HolidayCheckFailed",
-				fmt.Sprintf("Failed to check holiday ConfigMap %s: %v", *tws.Spec.HolidayConfigMap, err))
-		} else {
-			isHoliday = holiday
+				fmt.Sprintf("Failed to check holiday ConfigMap %s: %v", *schedule.HolidayConfigMap, err))
+		}
+		if holiday {
+			isHoliday = true
+			holidayName = name
 			// Emit event if holiday state changed
-			if isHoliday && !previousHolidayState {
+			if holidayName != tws.Status.Holiday {
 				r.Recorder.Event(tws, corev1.EventTypeNormal, "HolidayDetected",
-					fmt.Sprintf("Today is a holiday (mode: %s)", tws.Spec.HolidayMode))
+					fmt.Sprintf("Today is a holiday: %s (mode: %s)", holidayName, schedule.HolidayMode))
 			}
 		}
 	}
+	tws.Status.Holiday = holidayName
 
 	input := engine.Input{
-		Now:             r.Clock.Now(),
-		Timezone:        tws.Spec.Timezone,
-		Windows:         windows,
-		DefaultReplicas: tws.Spec.DefaultReplicas,
-		HolidayMode:     tws.Spec.HolidayMode,
-		IsHoliday:       isHoliday,
-		Pause:           tws.Spec.Pause,
+		Now:                r.Clock.Now(),
+		Timezone:           schedule.Timezone,
+		DSTPolicy:          schedule.DSTPolicy,
+		Windows:            windows,
+		DefaultReplicas:    tws.Spec.DefaultReplicas,
+		DefaultMaxReplicas: tws.Spec.DefaultMaxReplicas,
+		HolidayMode:        schedule.HolidayMode,
+		IsHoliday:          isHoliday,
+		Pause:              tws.Spec.Pause,
+		ConflictResolution: tws.Spec.ConflictResolution,
+	}
+
+	lead, err := windowspec.ConvertLeadTime(tws.Spec.LeadTime)
+	if err != nil {
+		err = fmt.Errorf("spec %w", err)
+		logger.Error(err, "Invalid lead time")
+		r.Recorder.Event(tws, corev1.EventTypeWarning, "InvalidLeadTime", err.Error())
+		return engine.Input{}, err
+	}
+	if lead != nil {
+		input.LeadTime = *lead
 	}
 
 	if tws.Spec.GracePeriodSeconds != nil {
@@ -391,6 +657,13 @@ func (r *TimeWindowScalerReconciler) buildEngineInput(ctx context.Context, tws *
 		input.LastScaleTime = &tws.Status.LastScaleTime.Time
 	}
 
+	if tws.Spec.Override != nil {
+		input.Override = &engine.Override{
+			Replicas: tws.Spec.Override.Replicas,
+			Until:    tws.Spec.Override.Until.Time,
+		}
+	}
+
 	if tws.Status.TargetObservedReplicas != nil {
 		input.CurrentReplicas = *tws.Status.TargetObservedReplicas
 	}
@@ -398,54 +671,69 @@ func (r *TimeWindowScalerReconciler) buildEngineInput(ctx context.Context, tws *
 	return input, nil
 }
 
-// checkHoliday checks if today is a holiday in the ConfigMap
-func (r *TimeWindowScalerReconciler) checkHoliday(ctx context.Context, namespace, configMapName, timezone string) (bool, error) {
+// checkHoliday checks if today is a holiday in the ConfigMap and returns its name
+func (r *TimeWindowScalerReconciler) checkHoliday(ctx context.Context, namespace, configMapName, timezone string) (string, bool, error) {
 	// Load timezone
-	var err error
 	loc, err := time.LoadLocation(timezone)
 	if err != nil {
-		return false, fmt.Errorf("invalid timezone %s: %w", timezone, err)
+		return "", false, fmt.Errorf("invalid timezone %s: %w", timezone, err)
+	}
+
+	// The calendar holds the valid keys even when some are invalid
+	calendar, err := r.holidayCalendar(ctx, namespace, configMapName)
+	if calendar == nil {
+		return "", false, err
 	}
 
-	// Get current date in the specified timezone
-	now := r.Clock.Now().In(loc)
-	todayKey := now.Format("2006-01-02") // YYYY-MM-DD format
+	// Check the current date in the specified timezone
+	name, holiday := calendar.Holiday(r.Clock.Now().In(loc))
+	return name, holiday, err
+}
 
+// holidayCalendar parses the holidays of the ConfigMap; a missing ConfigMap has no holidays.
+// Invalid keys are reported in the error, next to a calendar of the valid ones.
+func (r *TimeWindowScalerReconciler) holidayCalendar(ctx context.Context, namespace, configMapName string) (*engine.HolidayCalendar, error) {
 	// Fetch the ConfigMap
 	cm := &corev1.ConfigMap{}
-	if err = r.Get(ctx, types.NamespacedName{
+	if err := r.Get(ctx, types.NamespacedName{
 		Namespace: namespace,
 		Name:      configMapName,
 	}, cm); err != nil {
 		if apierrors.IsNotFound(err) {
-			// ConfigMap doesn't exist - not a holiday
-			return false, nil
-		}
-		return false, fmt.Errorf("failed to get ConfigMap: %w", err)
-	}
-
-	// Check if today's date is in the ConfigMap data
-	if cm.Data != nil {
-		if _, exists := cm.Data[todayKey]; exists {
-			return true, nil
+			// ConfigMap doesn't exist - no holidays
+			return &engine.HolidayCalendar{}, nil
 		}
+		return nil, fmt.Errorf("failed to get ConfigMap: %w", err)
 	}
 
-	return false, nil
+	return engine.ParseHolidays(cm.Data)
 }
 
-// scaleDeployment patches the deployment with new replica count
-func (r *TimeWindowScalerReconciler) scaleDeployment(ctx context.Context, deployment *appsv1.Deployment, replicas int32) error {
-	deployment.Spec.Replicas = &replicas
-	return r.Update(ctx, deployment)
+// upcomingStatus previews the schedule and returns its next transitions for status.upcoming
+func upcomingStatus(input engine.Input, isHoliday func(date time.Time) bool) []kyklosv1alpha1.UpcomingTransition {
+	transitions, err := engine.Preview(input, previewHorizon, isHoliday)
+	if err != nil {
+		return nil
+	}
+
+	upcoming := make([]kyklosv1alpha1.UpcomingTransition, 0, min(len(transitions), upcomingTransitions))
+	for _, transition := range transitions[:min(len(transitions), upcomingTransitions)] {
+		upcoming = append(upcoming, kyklosv1alpha1.UpcomingTransition{
+			Time:        metav1.NewTime(transition.Time),
+			Window:      transition.Window,
+			Replicas:    transition.EffectiveReplicas,
+			MaxReplicas: transition.EffectiveMaxReplicas,
+			Reason:      transition.Reason,
+		})
+	}
+	return upcoming
 }
 
-// handleMissingTarget handles case when target deployment is not found
-func (r *TimeWindowScalerReconciler) handleMissingTarget(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler) (ctrl.Result, error) {
+// handleMissingTarget handles case when target workload is not found
+func (r *TimeWindowScalerReconciler) handleMissingTarget(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler, message string) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
-	logger.Info("Target deployment not found",
-		"target", tws.Spec.TargetRef.Name,
-		"namespace", tws.Spec.TargetRef.Namespace)
+	logger.Info("Target workload not found",
+		"message", message)
 
 	// Set Degraded condition
 	degradedCondition := metav1.Condition{
@@ -454,7 +742,7 @@ func (r *TimeWindowScalerReconciler) handleMissingTarget(ctx context.Context, tw
 		ObservedGeneration: tws.Generation,
 		LastTransitionTime: metav1.Now(),
 		Reason:             "TargetNotFound",
-		Message:            fmt.Sprintf("Target deployment %s not found", tws.Spec.TargetRef.Name),
+		Message:            message,
 	}
 
 	meta.SetStatusCondition(&tws.Status.Conditions, degradedCondition)
@@ -468,50 +756,6 @@ func (r *TimeWindowScalerReconciler) handleMissingTarget(ctx context.Context, tw
 	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
 }
 
-// computeAndUpdateStatus computes status when paused
-func (r *TimeWindowScalerReconciler) computeAndUpdateStatus(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler, deployment *appsv1.Deployment) (ctrl.Result, error) {
-	engineInput, err := r.buildEngineInput(ctx, tws)
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-	engineOutput, err := engine.ComputeEffectiveReplicas(engineInput)
-	if err != nil {
-		return ctrl.Result{}, err
-	}
-
-	// Update status but don't scale
-	tws.Status.ObservedGeneration = tws.Generation
-	tws.Status.EffectiveReplicas = &engineOutput.EffectiveReplicas
-	tws.Status.TargetObservedReplicas = deployment.Spec.Replicas
-	tws.Status.CurrentWindow = engineOutput.CurrentWindow
-	nextBoundaryTime := metav1.NewTime(engineOutput.NextBoundary)
-	tws.Status.NextBoundary = &nextBoundaryTime
-
-	// Set Ready condition with paused state
-	readyCondition := metav1.Condition{
-		Type:               "Ready",
-		Status:             metav1.ConditionTrue,
-		ObservedGeneration: tws.Generation,
-		LastTransitionTime: metav1.Now(),
-		Reason:             "Paused",
-		Message:            "TimeWindowScaler is paused",
-	}
-
-	meta.SetStatusCondition(&tws.Status.Conditions, readyCondition)
-
-	if err = r.Status().Update(ctx, tws); err != nil {
-		return ctrl.Result{}, err
-	}
-
-	// Still requeue to update status
-	requeueAfter := engineOutput.NextBoundary.Sub(r.Clock.Now()) - 10*time.Second
-	if requeueAfter < 30*time.Second {
-		requeueAfter = 30 * time.Second
-	}
-
-	return ctrl.Result{RequeueAfter: requeueAfter}, nil
-}
-
 // mustLoadLocation loads a timezone location, panics on error (should not happen with validated input)
 func mustLoadLocation(tz string) *time.Location {
 	loc, err := time.LoadLocation(tz)
@@ -524,14 +768,47 @@ func mustLoadLocation(tz string) *time.Location {
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *TimeWindowScalerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Index scalers by their targets so workload changes map back to them
+	ctx := context.Background()
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &kyklosv1alpha1.TimeWindowScaler{},
+		targetRefIndexKey, indexTargetRef); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &kyklosv1alpha1.TimeWindowScaler{},
+		targetSelectorKindIndexKey, indexTargetSelectorKind); err != nil {
+		return err
+	}
+
+	// Target changes are picked up when their spec (e.g. replicas) or selection-relevant metadata changes
+	targetPredicate := builder.WithPredicates(targetChangedPredicate)
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&kyklosv1alpha1.TimeWindowScaler{}).
-		Owns(&appsv1.Deployment{}).
+		Watches(
+			&appsv1.Deployment{},
+			handler.EnqueueRequestsFromMapFunc(r.findTimeWindowScalersForTarget(kyklosv1alpha1.TargetKindDeployment)),
+			targetPredicate,
+		).
+		Watches(
+			&appsv1.StatefulSet{},
+			handler.EnqueueRequestsFromMapFunc(r.findTimeWindowScalersForTarget(kyklosv1alpha1.TargetKindStatefulSet)),
+			targetPredicate,
+		).
+		Watches(
+			&autoscalingv2.HorizontalPodAutoscaler{},
+			handler.EnqueueRequestsFromMapFunc(r.findTimeWindowScalersForTarget(kyklosv1alpha1.TargetKindHorizontalPodAutoscaler)),
+			targetPredicate,
+		).
 		Watches(
 			&corev1.ConfigMap{},
 			handler.EnqueueRequestsFromMapFunc(r.findTimeWindowScalersForConfigMap),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
 		).
+		Watches(
+			&kyklosv1alpha1.TimeWindowSchedule{},
+			handler.EnqueueRequestsFromMapFunc(r.findTimeWindowScalersForSchedule),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		Named("timewindowscaler").
 		Complete(r)
 }
@@ -548,10 +825,24 @@ func (r *TimeWindowScalerReconciler) findTimeWindowScalersForConfigMap(ctx conte
 		return nil
 	}
 
+	// Schedules in the namespace that reference the ConfigMap
+	scheduleList := &kyklosv1alpha1.TimeWindowScheduleList{}
+	if err := r.List(ctx, scheduleList, client.InNamespace(cm.Namespace)); err != nil {
+		logger.Error(err, "Failed to list TimeWindowSchedules for ConfigMap change", "configmap", cm.Name)
+		return nil
+	}
+	referencingSchedules := make(map[string]bool)
+	for _, schedule := range scheduleList.Items {
+		if schedule.Spec.HolidayConfigMap != nil && *schedule.Spec.HolidayConfigMap == cm.Name {
+			referencingSchedules[schedule.Name] = true
+		}
+	}
+
 	var requests []reconcile.Request
 	for _, tws := range twsList.Items {
-		// Check if this TWS references the ConfigMap
-		if tws.Spec.HolidayConfigMap != nil && *tws.Spec.HolidayConfigMap == cm.Name {
+		// Check if this TWS references the ConfigMap, directly or through its schedule
+		if (tws.Spec.HolidayConfigMap != nil && *tws.Spec.HolidayConfigMap == cm.Name) ||
+			(tws.Spec.ScheduleRef != nil && referencingSchedules[tws.Spec.ScheduleRef.Name]) {
 			logger.Info("ConfigMap changed, triggering reconciliation",
 				"configmap", cm.Name,
 				"timewindowscaler", tws.Name)
@@ -567,6 +858,36 @@ func (r *TimeWindowScalerReconciler) findTimeWindowScalersForConfigMap(ctx conte
 	return requests
 }
 
+// findTimeWindowScalersForSchedule finds all TimeWindowScaler resources that reference a TimeWindowSchedule
+func (r *TimeWindowScalerReconciler) findTimeWindowScalersForSchedule(ctx context.Context, obj client.Object) []reconcile.Request {
+	schedule := obj.(*kyklosv1alpha1.TimeWindowSchedule)
+	logger := log.FromContext(ctx)
+
+	// List all TimeWindowScalers in the same namespace
+	twsList := &kyklosv1alpha1.TimeWindowScalerList{}
+	if err := r.List(ctx, twsList, client.InNamespace(schedule.Namespace)); err != nil {
+		logger.Error(err, "Failed to list TimeWindowScalers for schedule change", "schedule", schedule.Name)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, tws := range twsList.Items {
+		if tws.Spec.ScheduleRef != nil && tws.Spec.ScheduleRef.Name == schedule.Name {
+			logger.Info("TimeWindowSchedule changed, triggering reconciliation",
+				"schedule", schedule.Name,
+				"timewindowscaler", tws.Name)
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      tws.Name,
+					Namespace: tws.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
 // handleDeletion handles the cleanup when a TimeWindowScaler is being deleted
 func (r *TimeWindowScalerReconciler) handleDeletion(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
@@ -587,6 +908,14 @@ func (r *TimeWindowScalerReconciler) handleDeletion(ctx context.Context, tws *ky
 		r.Recorder.Event(tws, corev1.EventTypeNormal, "Deleting",
 			"TimeWindowScaler is being deleted, cleaning up resources")
 
+		// Apply the deletion policy to the targets
+		if err := r.applyDeletionPolicy(ctx, tws); err != nil {
+			logger.Error(err, "Failed to apply deletion policy")
+			r.Recorder.Event(tws, corev1.EventTypeWarning, "DeletionPolicyFailed",
+				fmt.Sprintf("Failed to apply deletion policy %s: %v", tws.Spec.DeletionPolicy, err))
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+		}
+
 		// Remove finalizer
 		tws.ObjectMeta.Finalizers = removeString(tws.ObjectMeta.Finalizers, timeWindowScalerFinalizer)
 		if err := r.Update(ctx, tws); err != nil {
@@ -602,6 +931,98 @@ func (r *TimeWindowScalerReconciler) handleDeletion(ctx context.Context, tws *ky
 	return ctrl.Result{}, nil
 }
 
+// applyDeletionPolicy scales the targets according to spec.deletionPolicy and removes the
+// original replicas annotations; targets that no longer exist are skipped
+func (r *TimeWindowScalerReconciler) applyDeletionPolicy(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler) error {
+	targets, err := r.resolveTargets(ctx, tws)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			// The target kind no longer exists, so there is nothing to restore
+			return nil
+		}
+		return err
+	}
+
+	for _, target := range targets {
+		current, err := readTargetReplicas(ctx, r.Client, target)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		var replicas, maxReplicas *int32
+		switch tws.Spec.DeletionPolicy {
+		case kyklosv1alpha1.DeletionPolicyRestoreOriginal:
+			replicas, maxReplicas = r.originalReplicas(ctx, tws, target)
+		case kyklosv1alpha1.DeletionPolicySetDefault:
+			replicas, maxReplicas = &tws.Spec.DefaultReplicas, tws.Spec.DefaultMaxReplicas
+		}
+
+		if replicas != nil {
+			desired := current.withBounds(*replicas, maxReplicas)
+			if !desired.equal(current) {
+				if err := writeTargetReplicas(ctx, r.Client, target, desired); err != nil {
+					return fmt.Errorf("failed to scale %s from %s to %s: %w", target, current, desired, err)
+				}
+				r.Recorder.Event(tws, corev1.EventTypeNormal, "Restored",
+					fmt.Sprintf("Scaled %s from %s to %s (deletion policy: %s)",
+						target, current, desired, tws.Spec.DeletionPolicy))
+			}
+		}
+
+		if !target.keepsOriginalAnnotations() {
+			continue
+		}
+		if err := clearOriginalReplicas(ctx, r.Client, target); err != nil && !apierrors.IsNotFound(err) {
+			log.FromContext(ctx).Error(err, "Failed to remove original replicas annotations",
+				"target", target.String())
+		}
+	}
+
+	return nil
+}
+
+// originalReplicas returns the pre-management replicas of a target from status or, failing that,
+// from the annotations on the target; nil when they were never recorded
+func (r *TimeWindowScalerReconciler) originalReplicas(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler, target *targetWorkload) (*int32, *int32) {
+	for _, ts := range tws.Status.Targets {
+		if ts.Namespace == target.Namespace && ts.Name == target.Name && ts.OriginalReplicas != nil {
+			return ts.OriginalReplicas, ts.OriginalMaxReplicas
+		}
+	}
+	if !target.keepsOriginalAnnotations() {
+		return nil, nil
+	}
+	replicas, maxReplicas, err := readOriginalReplicas(ctx, r.Client, target)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to read original replicas annotations",
+			"target", target.String())
+		return nil, nil
+	}
+	return replicas, maxReplicas
+}
+
+// ensureOriginalReplicas returns the pre-management replicas of a target, recording the
+// current replicas in annotations on the target the first time it is managed
+func (r *TimeWindowScalerReconciler) ensureOriginalReplicas(ctx context.Context, tws *kyklosv1alpha1.TimeWindowScaler, target *targetWorkload, current *targetReplicas) (*int32, *int32) {
+	if replicas, maxReplicas := r.originalReplicas(ctx, tws, target); replicas != nil {
+		return replicas, maxReplicas
+	}
+
+	original := *current
+	if !target.keepsOriginalAnnotations() {
+		return &original.Replicas, original.MaxReplicas
+	}
+	if err := recordOriginalReplicas(ctx, r.Client, target, &original); err != nil {
+		// The status entry still records the original replicas
+		log.FromContext(ctx).Error(err, "Failed to record original replicas annotations",
+			"target", target.String())
+	}
+	return &original.Replicas, original.MaxReplicas
+}
+
 // setErrorCondition sets an error condition on the TimeWindowScaler
 func (r *TimeWindowScalerReconciler) setErrorCondition(tws *kyklosv1alpha1.TimeWindowScaler, reason, message string) {
 	errorCondition := metav1.Condition{
@@ -635,12 +1056,3 @@ func removeString(slice []string, s string) []string {
 	}
 	return result
 }
-
-// validateTimeFormat validates HH:MM time format
-func (r *TimeWindowScalerReconciler) validateTimeFormat(timeStr string) error {
-	_, err := time.Parse("15:04", timeStr)
-	if err != nil {
-		return fmt.Errorf("invalid time format (expected HH:MM): %w", err)
-	}
-	return nil
-}
@@ -23,11 +23,17 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
@@ -424,6 +430,8 @@ var _ = Describe("TimeWindowScaler Controller", func() {
 				Data: map[string]string{
 					"2025-01-01": "New Year's Day",
 					"2025-12-25": "Christmas",
+					"07-04":      "Independence Day",
+					"11-4th-thu": "Thanksgiving",
 				},
 			}
 			Expect(k8sClient.Create(ctx, holidayConfigMap)).To(Succeed())
@@ -527,6 +535,10 @@ var _ = Describe("TimeWindowScaler Controller", func() {
 				return *holidayDeployment.Spec.Replicas
 			}, timeout, interval).Should(Equal(int32(0)))
 
+			// The holiday is named in status
+			Expect(k8sClient.Get(ctx, req.NamespacedName, holidayTWS)).To(Succeed())
+			Expect(holidayTWS.Status.Holiday).To(Equal("New Year's Day"))
+
 			// Now test with non-holiday date
 			fakeClock.Time = time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC) // Regular Monday at 10 AM
 			_, err = reconciler.Reconcile(ctx, req)
@@ -543,6 +555,35 @@ var _ = Describe("TimeWindowScaler Controller", func() {
 				}
 				return *holidayDeployment.Spec.Replicas
 			}, timeout, interval).Should(Equal(int32(5)))
+			Expect(k8sClient.Get(ctx, req.NamespacedName, holidayTWS)).To(Succeed())
+			Expect(holidayTWS.Status.Holiday).To(BeEmpty())
+
+			// Exact dates apply in their year only
+			fakeClock.Time = time.Date(2025, 12, 25, 10, 0, 0, 0, time.UTC) // Christmas at 10 AM
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, req.NamespacedName, holidayTWS)).To(Succeed())
+			Expect(holidayTWS.Status.Holiday).To(Equal("Christmas"))
+
+			fakeClock.Time = time.Date(2026, 12, 25, 10, 0, 0, 0, time.UTC)
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, req.NamespacedName, holidayTWS)).To(Succeed())
+			Expect(holidayTWS.Status.Holiday).To(BeEmpty())
+
+			// Annual dates recur every year
+			fakeClock.Time = time.Date(2027, 7, 4, 10, 0, 0, 0, time.UTC) // Independence Day at 10 AM
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, req.NamespacedName, holidayTWS)).To(Succeed())
+			Expect(holidayTWS.Status.Holiday).To(Equal("Independence Day"))
+
+			// Weekday rules recur every year
+			fakeClock.Time = time.Date(2027, 11, 25, 10, 0, 0, 0, time.UTC) // Thanksgiving at 10 AM
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, req.NamespacedName, holidayTWS)).To(Succeed())
+			Expect(holidayTWS.Status.Holiday).To(Equal("Thanksgiving"))
 		})
 
 		It("Should handle grace period for scale-down operations", func() {
@@ -982,9 +1023,1626 @@ var _ = Describe("TimeWindowScaler Controller", func() {
 			}, scaleUpTWS)).To(Succeed())
 			Expect(scaleUpTWS.Status.GracePeriodExpiry).To(BeNil())
 		})
-	})
-})
 
-func ptr(i int32) *int32 {
-	return &i
-}
+		It("Should scale StatefulSet targets", func() {
+			statefulSetName := "statefulset-test-target"
+			statefulSetTWSName := "statefulset-test-tws"
+
+			statefulSet := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      statefulSetName,
+					Namespace: namespace,
+				},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr(1),
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app": "statefulset-test",
+						},
+					},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"app": "statefulset-test",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  "test",
+									Image: "nginx:latest",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, statefulSet)).To(Succeed())
+
+			statefulSetTWS := &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      statefulSetTWSName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						APIVersion: "apps/v1",
+						Kind:       kyklosv1alpha1.TargetKindStatefulSet,
+						Name:       statefulSetName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:    "09:00",
+							End:      "17:00",
+							Replicas: 3,
+							Name:     "business-hours",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, statefulSetTWS)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      statefulSetTWSName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// Monday 10:00 UTC is inside the window - StatefulSet scales to 3
+			Eventually(func() int32 {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      statefulSetName,
+					Namespace: namespace,
+				}, statefulSet)
+				if err != nil {
+					return -1
+				}
+				return *statefulSet.Spec.Replicas
+			}, timeout, interval).Should(Equal(int32(3)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.TargetObservedReplicas).NotTo(BeNil())
+			Expect(*updatedTWS.Status.TargetObservedReplicas).To(Equal(int32(3)))
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("business-hours"))
+			Expect(meta.IsStatusConditionTrue(updatedTWS.Status.Conditions, "Ready")).To(BeTrue())
+		})
+
+		It("Should scale any target exposing the scale subresource", func() {
+			replicaSetName := "replicaset-test-target"
+			replicaSetTWSName := "replicaset-test-tws"
+
+			replicaSet := &appsv1.ReplicaSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      replicaSetName,
+					Namespace: namespace,
+				},
+				Spec: appsv1.ReplicaSetSpec{
+					Replicas: ptr(1),
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app": "replicaset-test",
+						},
+					},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"app": "replicaset-test",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  "test",
+									Image: "nginx:latest",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, replicaSet)).To(Succeed())
+
+			replicaSetTWS := &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      replicaSetTWSName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						APIVersion: "apps/v1",
+						Kind:       "ReplicaSet",
+						Name:       replicaSetName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:    "09:00",
+							End:      "17:00",
+							Replicas: 4,
+							Name:     "business-hours",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, replicaSetTWS)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      replicaSetTWSName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Eventually(func() int32 {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      replicaSetName,
+					Namespace: namespace,
+				}, replicaSet)
+				if err != nil {
+					return -1
+				}
+				return *replicaSet.Spec.Replicas
+			}, timeout, interval).Should(Equal(int32(4)))
+		})
+
+		It("Should report an invalid target kind", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						APIVersion: "example.com/v1",
+						Kind:       "DoesNotExist",
+						Name:       deploymentName,
+					},
+					DefaultReplicas: 1,
+					Timezone:        "UTC",
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			cond := meta.FindStatusCondition(updatedTWS.Status.Conditions, "Ready")
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("InvalidTarget"))
+		})
+
+		It("Should set HorizontalPodAutoscaler bounds instead of replicas", func() {
+			hpaName := "hpa-test-target"
+			hpaTWSName := "hpa-test-tws"
+
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      hpaName,
+					Namespace: namespace,
+				},
+				Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       deploymentName,
+					},
+					MinReplicas: ptr(1),
+					MaxReplicas: 5,
+				},
+			}
+			Expect(k8sClient.Create(ctx, hpa)).To(Succeed())
+
+			hpaTWS := &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      hpaTWSName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						APIVersion: "autoscaling/v2",
+						Kind:       kyklosv1alpha1.TargetKindHorizontalPodAutoscaler,
+						Name:       hpaName,
+					},
+					Timezone:           "UTC",
+					DefaultReplicas:    1,
+					DefaultMaxReplicas: ptr(5),
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:       "09:00",
+							End:         "17:00",
+							Replicas:    10,
+							MaxReplicas: ptr(50),
+							Name:        "business-hours",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, hpaTWS)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      hpaTWSName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// Monday 10:00 UTC - business hours bounds apply
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: hpaName, Namespace: namespace}, hpa)).To(Succeed())
+			Expect(*hpa.Spec.MinReplicas).To(Equal(int32(10)))
+			Expect(hpa.Spec.MaxReplicas).To(Equal(int32(50)))
+
+			// The Deployment itself is left for the HPA to manage
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(1)))
+
+			// Night - default bounds apply
+			reconciler.Clock = engine.FakeClock{Time: time.Date(2025, 3, 10, 22, 0, 0, 0, time.UTC)}
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: hpaName, Namespace: namespace}, hpa)).To(Succeed())
+			Expect(*hpa.Spec.MinReplicas).To(Equal(int32(1)))
+			Expect(hpa.Spec.MaxReplicas).To(Equal(int32(5)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.EffectiveMaxReplicas).NotTo(BeNil())
+			Expect(*updatedTWS.Status.EffectiveMaxReplicas).To(Equal(int32(5)))
+		})
+
+		It("Should scale every workload matching a label selector", func() {
+			selectorTWSName := "selector-test-tws"
+			selected := []string{"selector-test-a", "selector-test-b"}
+			unselected := "selector-test-other"
+
+			for _, name := range append([]string{unselected}, selected...) {
+				tier := "batch"
+				if name == unselected {
+					tier = "web"
+				}
+				deployment := &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: namespace,
+						Labels: map[string]string{
+							"tier": tier,
+						},
+					},
+					Spec: appsv1.DeploymentSpec{
+						Replicas: ptr(1),
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"app": name,
+							},
+						},
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: map[string]string{
+									"app": name,
+								},
+							},
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{
+										Name:  "test",
+										Image: "nginx:latest",
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, deployment)).To(Succeed())
+			}
+
+			selectorTWS := &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      selectorTWSName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					Selector: &kyklosv1alpha1.TargetSelector{
+						APIVersion: "apps/v1",
+						Kind:       kyklosv1alpha1.TargetKindDeployment,
+						LabelSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"tier": "batch",
+							},
+						},
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:    "09:00",
+							End:      "17:00",
+							Replicas: 4,
+							Name:     "business-hours",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, selectorTWS)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      selectorTWSName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// Matching deployments scale to 4, the other one is left alone
+			for _, name := range selected {
+				Eventually(func() int32 {
+					deployment := &appsv1.Deployment{}
+					err := k8sClient.Get(ctx, types.NamespacedName{
+						Name:      name,
+						Namespace: namespace,
+					}, deployment)
+					if err != nil {
+						return -1
+					}
+					return *deployment.Spec.Replicas
+				}, timeout, interval).Should(Equal(int32(4)))
+			}
+			other := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: unselected, Namespace: namespace}, other)).To(Succeed())
+			Expect(*other.Spec.Replicas).To(Equal(int32(1)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.Targets).To(HaveLen(2))
+			for i, target := range updatedTWS.Status.Targets {
+				Expect(target.Name).To(Equal(selected[i]))
+				Expect(target.Kind).To(Equal(kyklosv1alpha1.TargetKindDeployment))
+				Expect(*target.ObservedReplicas).To(Equal(int32(4)))
+				Expect(target.Message).To(BeEmpty())
+			}
+			Expect(updatedTWS.Status.TargetObservedReplicas).To(BeNil())
+			Expect(meta.IsStatusConditionTrue(updatedTWS.Status.Conditions, "Ready")).To(BeTrue())
+		})
+
+		It("Should scale using a referenced TimeWindowSchedule", func() {
+			schedule := &kyklosv1alpha1.TimeWindowSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shared-schedule-" + twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScheduleSpec{
+					Timezone: "UTC",
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:    "09:00",
+							End:      "17:00",
+							Replicas: 6,
+							Name:     "shared-business-hours",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, schedule)).To(Succeed())
+			defer func() {
+				_ = k8sClient.Delete(ctx, schedule)
+			}()
+
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					ScheduleRef: &kyklosv1alpha1.ScheduleRef{
+						Name: schedule.Name,
+					},
+					DefaultReplicas: 1,
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// Monday 10:00 UTC is inside the shared window
+			Eventually(func() int32 {
+				err := k8sClient.Get(ctx, types.NamespacedName{
+					Name:      deploymentName,
+					Namespace: namespace,
+				}, deployment)
+				if err != nil {
+					return -1
+				}
+				return *deployment.Spec.Replicas
+			}, timeout, interval).Should(Equal(int32(6)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("shared-business-hours"))
+
+			// Editing the schedule re-enqueues every referencing scaler
+			requests := reconciler.findTimeWindowScalersForSchedule(ctx, schedule)
+			Expect(requests).To(ConsistOf(req))
+		})
+
+		It("Should report a missing TimeWindowSchedule", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					ScheduleRef: &kyklosv1alpha1.ScheduleRef{
+						Name: "does-not-exist",
+					},
+					DefaultReplicas: 1,
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			condition := meta.FindStatusCondition(updatedTWS.Status.Conditions, "Ready")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("ScheduleNotFound"))
+		})
+
+		It("Should restore original replicas when deleted with RestoreOriginal", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 2,
+					DeletionPolicy:  kyklosv1alpha1.DeletionPolicyRestoreOriginal,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:    "09:00",
+							End:      "17:00",
+							Replicas: 5,
+							Name:     "business-hours",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// The deployment is scaled and its original replicas recorded
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(5)))
+			Expect(deployment.Annotations).To(HaveKeyWithValue(kyklosv1alpha1.OriginalReplicasAnnotation, "1"))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.Targets).To(HaveLen(1))
+			Expect(updatedTWS.Status.Targets[0].OriginalReplicas).NotTo(BeNil())
+			Expect(*updatedTWS.Status.Targets[0].OriginalReplicas).To(Equal(int32(1)))
+
+			// Deleting the scaler restores the original replicas
+			Expect(k8sClient.Delete(ctx, updatedTWS)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(1)))
+			Expect(deployment.Annotations).NotTo(HaveKey(kyklosv1alpha1.OriginalReplicasAnnotation))
+		})
+
+		It("Should scale to default replicas when deleted with SetDefault", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 2,
+					DeletionPolicy:  kyklosv1alpha1.DeletionPolicySetDefault,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:    "09:00",
+							End:      "17:00",
+							Replicas: 5,
+							Name:     "business-hours",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(5)))
+
+			// Deleting the scaler scales the target to defaultReplicas
+			Expect(k8sClient.Delete(ctx, tws)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(2)))
+		})
+
+		It("Should honour a temporary override until it expires", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:    "09:00",
+							End:      "17:00",
+							Replicas: 3,
+							Name:     "business-hours",
+						},
+					},
+					Override: &kyklosv1alpha1.ReplicaOverride{
+						Replicas: 7,
+						Until:    metav1.NewTime(time.Date(2025, 3, 10, 11, 0, 0, 0, time.UTC)),
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// Monday 10:00 UTC is before the override expires
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(7)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("Override"))
+
+			// Once the override expires the schedule resumes
+			reconciler.Clock = engine.FakeClock{Time: time.Date(2025, 3, 10, 11, 30, 0, 0, time.UTC)}
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(3)))
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("business-hours"))
+		})
+
+		It("Should keep manual scaling until the next boundary with AllowUntilNextBoundary", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					DriftPolicy:     kyklosv1alpha1.DriftPolicyAllowUntilNextBoundary,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:    "09:00",
+							End:      "17:00",
+							Replicas: 3,
+							Name:     "business-hours",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(3)))
+
+			// Scale up manually
+			deployment.Spec.Replicas = ptr(8)
+			Expect(k8sClient.Update(ctx, deployment)).To(Succeed())
+
+			// The manual change is kept and reported
+			reconciler.Clock = engine.FakeClock{Time: time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)}
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(8)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			drift := meta.FindStatusCondition(updatedTWS.Status.Conditions, "DriftDetected")
+			Expect(drift).NotTo(BeNil())
+			Expect(drift.Status).To(Equal(metav1.ConditionTrue))
+			Expect(drift.Reason).To(Equal(kyklosv1alpha1.DriftPolicyAllowUntilNextBoundary))
+			Expect(updatedTWS.Status.Targets[0].DriftAcceptedUntil).NotTo(BeNil())
+
+			// Past the boundary the schedule applies again
+			reconciler.Clock = engine.FakeClock{Time: time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC)}
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(1)))
+		})
+
+		It("Should revert manual scaling with the default drift policy", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:    "09:00",
+							End:      "17:00",
+							Replicas: 3,
+							Name:     "business-hours",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// Scale up manually
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			deployment.Spec.Replicas = ptr(8)
+			Expect(k8sClient.Update(ctx, deployment)).To(Succeed())
+
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(3)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			drift := meta.FindStatusCondition(updatedTWS.Status.Conditions, "DriftDetected")
+			Expect(drift).NotTo(BeNil())
+			Expect(drift.Status).To(Equal(metav1.ConditionTrue))
+			Expect(drift.Reason).To(Equal(kyklosv1alpha1.DriftPolicyRevert))
+		})
+
+		It("Should scale during cron windows", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							StartCron: "0 9 * * MON",
+							Duration:  &metav1.Duration{Duration: 2 * time.Hour},
+							Replicas:  6,
+							Name:      "monday-morning",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// Monday 10:00 UTC is inside the 09:00-11:00 occurrence
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(6)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("monday-morning"))
+			Expect(updatedTWS.Status.NextBoundary.Time).To(BeTemporally("==", time.Date(2025, 3, 10, 11, 0, 0, 0, time.UTC)))
+		})
+
+		It("Should reject windows mixing start/end with startCron", func() {
+			invalid := &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:     "09:00",
+							End:       "17:00",
+							StartCron: "0 9 * * *",
+							Duration:  &metav1.Duration{Duration: time.Hour},
+							Replicas:  3,
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, invalid)).NotTo(Succeed())
+		})
+
+		It("Should apply windows only in their months and date range", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:    "09:00",
+							End:      "17:00",
+							Months:   []string{"March"},
+							Replicas: 4,
+							Name:     "march",
+						},
+						{
+							Start:     "09:00",
+							End:       "17:00",
+							StartDate: "2025-03-11",
+							EndDate:   "2025-03-14",
+							Replicas:  9,
+							Name:      "campaign",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// Monday March 10 is before the campaign starts
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(4)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("march"))
+			Expect(updatedTWS.Status.NextBoundary.Time).To(BeTemporally("==", time.Date(2025, 3, 10, 17, 0, 0, 0, time.UTC)))
+		})
+
+		It("Should reject an endDate before the startDate", func() {
+			invalid := &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:     "09:00",
+							End:       "17:00",
+							StartDate: "2025-12-01",
+							EndDate:   "2025-11-28",
+							Replicas:  3,
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, invalid)).NotTo(Succeed())
+		})
+
+		It("Should reject zero days of the month and weekday occurrences", func() {
+			for _, window := range []kyklosv1alpha1.TimeWindow{
+				{Start: "09:00", End: "17:00", DaysOfMonth: []int32{15, 0}, Replicas: 3},
+				{Start: "09:00", End: "17:00", BusinessDaysOfMonth: []int32{0}, Replicas: 3},
+				{Start: "09:00", End: "17:00", BusinessDaysOfMonth: []int32{-24}, Replicas: 3},
+				{Start: "09:00", End: "17:00", WeekdayOccurrence: &kyklosv1alpha1.WeekdayOccurrence{Weekday: "Friday"}, Replicas: 3},
+			} {
+				invalid := &kyklosv1alpha1.TimeWindowScaler{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      twsName,
+						Namespace: namespace,
+					},
+					Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+						TargetRef: kyklosv1alpha1.TargetRef{
+							Kind: "Deployment",
+							Name: deploymentName,
+						},
+						Timezone:        "UTC",
+						DefaultReplicas: 1,
+						Windows:         []kyklosv1alpha1.TimeWindow{window},
+					},
+				}
+				Expect(k8sClient.Create(ctx, invalid)).NotTo(Succeed())
+			}
+		})
+
+		It("Should apply one-off windows and delete them once expired", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{Start: "09:00", End: "17:00", Replicas: 3, Name: "business-hours"},
+					},
+					OneOffWindows: []kyklosv1alpha1.OneOffWindow{
+						{Name: "preview", Start: "2025-03-09T08:00", End: "2025-03-09T20:00", Replicas: 5},
+						{Name: "launch", Start: "2025-03-10T09:00", End: "2025-03-10T12:00", Replicas: 8},
+					},
+					ExpiredWindowPolicy: kyklosv1alpha1.ExpiredWindowPolicyDelete,
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// The launch takes precedence over business hours
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(8)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("launch"))
+			Expect(updatedTWS.Status.ExpiredWindows).To(ConsistOf("preview"))
+			Expect(updatedTWS.Spec.OneOffWindows).To(HaveLen(1))
+			Expect(updatedTWS.Spec.OneOffWindows[0].Name).To(Equal("launch"))
+		})
+
+		It("Should keep a one-off window added while expired windows are deleted", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{Start: "09:00", End: "17:00", Replicas: 3, Name: "business-hours"},
+					},
+					OneOffWindows: []kyklosv1alpha1.OneOffWindow{
+						{Name: "preview", Start: "2025-03-09T08:00", End: "2025-03-09T20:00", Replicas: 5},
+					},
+					ExpiredWindowPolicy: kyklosv1alpha1.ExpiredWindowPolicyDelete,
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// Read the TWS, then add a window before the expired one is deleted
+			stale := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, stale)).To(Succeed())
+			current := stale.DeepCopy()
+			current.Spec.OneOffWindows = append(current.Spec.OneOffWindows,
+				kyklosv1alpha1.OneOffWindow{Name: "launch", Start: "2025-03-10T09:00", End: "2025-03-10T12:00", Replicas: 8})
+			Expect(k8sClient.Update(ctx, current)).To(Succeed())
+
+			err := reconciler.deleteExpiredWindows(ctx, stale, []string{"preview"})
+			Expect(apierrors.IsConflict(err)).To(BeTrue(), "expected a conflict, got %v", err)
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Spec.OneOffWindows).To(HaveLen(2))
+
+			// Reconciling deletes the expired window from the current spec
+			for range 3 {
+				result, err := reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+				if !result.Requeue {
+					break
+				}
+			}
+
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Spec.OneOffWindows).To(HaveLen(1))
+			Expect(updatedTWS.Spec.OneOffWindows[0].Name).To(Equal("launch"))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(8)))
+		})
+
+		It("Should resolve overlapping windows by priority and report the matches", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:           "UTC",
+					DefaultReplicas:    1,
+					ConflictResolution: kyklosv1alpha1.ConflictResolutionHighestPriority,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{Start: "09:00", End: "17:00", Replicas: 4, Priority: 10, Name: "business-hours"},
+						{Start: "08:00", End: "12:00", Replicas: 7, Priority: 1, Name: "morning"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// Both windows match at 10:00; the earlier one has the higher priority
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(4)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("business-hours"))
+			Expect(updatedTWS.Status.MatchedWindows).To(Equal([]string{"business-hours", "morning"}))
+		})
+
+		It("Should publish the upcoming transitions", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:    "09:00",
+							End:      "17:00",
+							Replicas: 4,
+							Days:     []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"},
+							Name:     "business-hours",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// Monday 10:00: the window ends at 17:00 and restarts on Tuesday at 09:00
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.Upcoming).To(HaveLen(5))
+			Expect(updatedTWS.Status.Upcoming[0].Time.UTC()).To(Equal(time.Date(2025, 3, 10, 17, 0, 0, 0, time.UTC)))
+			Expect(updatedTWS.Status.Upcoming[0].Window).To(Equal("Default"))
+			Expect(updatedTWS.Status.Upcoming[0].Replicas).To(Equal(int32(1)))
+			Expect(updatedTWS.Status.Upcoming[1].Time.UTC()).To(Equal(time.Date(2025, 3, 11, 9, 0, 0, 0, time.UTC)))
+			Expect(updatedTWS.Status.Upcoming[1].Window).To(Equal("business-hours"))
+			Expect(updatedTWS.Status.Upcoming[1].Replicas).To(Equal(int32(4)))
+			Expect(updatedTWS.Status.Upcoming[1].Reason).To(Equal("in-window"))
+		})
+
+		It("Should ramp the replicas up in steps after a window starts", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "UTC",
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							Start:        "10:00",
+							End:          "17:00",
+							Replicas:     9,
+							RampDuration: &metav1.Duration{Duration: 40 * time.Minute},
+							StepSize:     ptr(int32(2)),
+							Name:         "peak",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// 10:15 is the second of four 10 minute steps from 1 to 9 replicas
+			reconciler.Clock = engine.FakeClock{Time: time.Date(2025, 3, 10, 10, 15, 0, 0, time.UTC)}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(3)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(*updatedTWS.Status.EffectiveReplicas).To(Equal(int32(3)))
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("peak"))
+			Expect(updatedTWS.Status.NextBoundary.Time).To(BeTemporally("==", time.Date(2025, 3, 10, 10, 20, 0, 0, time.UTC)))
+			Expect(updatedTWS.Status.Upcoming).NotTo(BeEmpty())
+			Expect(updatedTWS.Status.Upcoming[0].Time.UTC()).To(Equal(time.Date(2025, 3, 10, 10, 20, 0, 0, time.UTC)))
+			Expect(updatedTWS.Status.Upcoming[0].Replicas).To(Equal(int32(5)))
+			Expect(updatedTWS.Status.Upcoming[0].Reason).To(Equal("ramping"))
+
+			// After the ramp the window's replicas apply
+			reconciler.Clock = engine.FakeClock{Time: time.Date(2025, 3, 10, 10, 45, 0, 0, time.UTC)}
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(9)))
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(*updatedTWS.Status.EffectiveReplicas).To(Equal(int32(9)))
+			Expect(updatedTWS.Status.NextBoundary.Time).To(BeTemporally("==", time.Date(2025, 3, 10, 17, 0, 0, 0, time.UTC)))
+		})
+
+		It("Should apply the DST policy to a window starting in a DST gap", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:        "Europe/Berlin",
+					DSTPolicy:       kyklosv1alpha1.DSTPolicySkip,
+					DefaultReplicas: 1,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{Start: "02:30", End: "04:00", Replicas: 6, Name: "nightly-batch"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// Clocks in Berlin skip from 02:00 to 03:00 on March 30 2025; 01:15 UTC is 03:15 local
+			reconciler.Clock = engine.FakeClock{Time: time.Date(2025, 3, 30, 1, 15, 0, 0, time.UTC)}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// Skip drops the occurrence, so the window next starts at 02:30 local on March 31
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(1)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("Default"))
+			Expect(updatedTWS.Status.NextBoundary.Time).To(BeTemporally("==", time.Date(2025, 3, 31, 0, 30, 0, 0, time.UTC)))
+
+			// ShiftForward starts the occurrence at the end of the gap, 03:00 local
+			updatedTWS.Spec.DSTPolicy = kyklosv1alpha1.DSTPolicyShiftForward
+			Expect(k8sClient.Update(ctx, updatedTWS)).To(Succeed())
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(6)))
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("nightly-batch"))
+			Expect(*updatedTWS.Status.EffectiveReplicas).To(Equal(int32(6)))
+			Expect(updatedTWS.Status.NextBoundary.Time).To(BeTemporally("==", time.Date(2025, 3, 30, 2, 0, 0, 0, time.UTC)))
+		})
+
+		It("Should scale during a multi-day window spanning the weekend", func() {
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:           "UTC",
+					DefaultReplicas:    1,
+					GracePeriodSeconds: ptr(int32(0)),
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{
+							StartDay: "Friday",
+							Start:    "18:00",
+							EndDay:   "Monday",
+							End:      "06:00",
+							Replicas: 4,
+							Name:     "weekend-batch",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// Saturday noon is inside the Friday 18:00 to Monday 06:00 window
+			reconciler.Clock = engine.FakeClock{Time: time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(4)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("weekend-batch"))
+			Expect(*updatedTWS.Status.EffectiveReplicas).To(Equal(int32(4)))
+			Expect(updatedTWS.Status.NextBoundary.Time).To(BeTemporally("==", time.Date(2025, 3, 17, 6, 0, 0, 0, time.UTC)))
+
+			// Monday after 06:00 the window has ended until Friday
+			reconciler.Clock = engine.FakeClock{Time: time.Date(2025, 3, 17, 7, 0, 0, 0, time.UTC)}
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(1)))
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("Default"))
+			Expect(updatedTWS.Status.NextBoundary.Time).To(BeTemporally("==", time.Date(2025, 3, 21, 18, 0, 0, 0, time.UTC)))
+		})
+
+		It("Should read holidays from an iCalendar ConfigMap key", func() {
+			holidayConfigMapName := twsName + "-calendar"
+			calendar := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: holidayConfigMapName, Namespace: namespace},
+				Data: map[string]string{
+					"holidays.ics": "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20240310\r\n" +
+						"RRULE:FREQ=YEARLY\r\nSUMMARY:Founders Day\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+				},
+			}
+			Expect(k8sClient.Create(ctx, calendar)).To(Succeed())
+			DeferCleanup(func() { _ = k8sClient.Delete(ctx, calendar) })
+
+			tws = &kyklosv1alpha1.TimeWindowScaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+				Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+					TargetRef: kyklosv1alpha1.TargetRef{
+						Kind: "Deployment",
+						Name: deploymentName,
+					},
+					Timezone:         "UTC",
+					DefaultReplicas:  1,
+					HolidayMode:      "treat-as-closed",
+					HolidayConfigMap: &holidayConfigMapName,
+					Windows: []kyklosv1alpha1.TimeWindow{
+						{Start: "09:00", End: "17:00", Replicas: 4, Name: "business-hours"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, tws)).To(Succeed())
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      twsName,
+					Namespace: namespace,
+				},
+			}
+
+			// First reconcile adds finalizer
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			if result.Requeue || result.RequeueAfter > 0 {
+				_, err = reconciler.Reconcile(ctx, req)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// The yearly event recurs on Monday 2025-03-10
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(0)))
+
+			updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+			Expect(k8sClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+			Expect(updatedTWS.Status.CurrentWindow).To(Equal("Holiday-Closed"))
+			Expect(updatedTWS.Status.Holiday).To(Equal("Founders Day"))
+		})
+	})
+})
+
+func ptr(i int32) *int32 {
+	return &i
+}
+
+var _ = Describe("TimeWindowScaler selector targets", func() {
+	It("Should keep scaling the other workloads when one is deleted after listing", func() {
+		ctx := context.Background()
+
+		newDeployment := func(name string) *appsv1.Deployment {
+			return &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "apps",
+					Labels:    map[string]string{"tier": "web"},
+				},
+				Spec: appsv1.DeploymentSpec{Replicas: ptr(1)},
+			}
+		}
+		tws := &kyklosv1alpha1.TimeWindowScaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "web-tws",
+				Namespace:  "apps",
+				Finalizers: []string{timeWindowScalerFinalizer},
+			},
+			Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+				Selector: &kyklosv1alpha1.TargetSelector{
+					LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}},
+				},
+				Timezone:        "UTC",
+				DefaultReplicas: 1,
+				Windows: []kyklosv1alpha1.TimeWindow{
+					{Start: "09:00", End: "17:00", Replicas: 4, Name: "business-hours"},
+				},
+			},
+		}
+
+		// web-b disappears between the List and the scale Get
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithRESTMapper(testRESTMapper()).
+			WithObjects(tws, newDeployment("web-a"), newDeployment("web-b"), newDeployment("web-c")).
+			WithStatusSubresource(tws).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceGet: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceGetOption) error {
+					if obj.GetName() == "web-b" {
+						return apierrors.NewNotFound(appsv1.Resource("deployments"), obj.GetName())
+					}
+					deployment := &appsv1.Deployment{}
+					if err := c.Get(ctx, client.ObjectKeyFromObject(obj), deployment); err != nil {
+						return err
+					}
+					subResource.(*autoscalingv1.Scale).Spec.Replicas = *deployment.Spec.Replicas
+					return nil
+				},
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					if subResourceName != "scale" {
+						return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+					}
+					deployment := &appsv1.Deployment{}
+					if err := c.Get(ctx, client.ObjectKeyFromObject(obj), deployment); err != nil {
+						return err
+					}
+					updateOpts := &client.SubResourceUpdateOptions{}
+					updateOpts.ApplyOptions(opts)
+					deployment.Spec.Replicas = ptr(updateOpts.SubResourceBody.(*autoscalingv1.Scale).Spec.Replicas)
+					return c.Update(ctx, deployment)
+				},
+			}).
+			Build()
+		reconciler := &TimeWindowScalerReconciler{
+			Client:   fakeClient,
+			Scheme:   fakeClient.Scheme(),
+			Recorder: record.NewFakeRecorder(100),
+			Clock:    engine.FakeClock{Time: time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)},
+		}
+
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "web-tws", Namespace: "apps"}}
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, name := range []string{"web-a", "web-c"} {
+			deployment := &appsv1.Deployment{}
+			Expect(fakeClient.Get(ctx, types.NamespacedName{Name: name, Namespace: "apps"}, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(4)), name)
+		}
+
+		updatedTWS := &kyklosv1alpha1.TimeWindowScaler{}
+		Expect(fakeClient.Get(ctx, req.NamespacedName, updatedTWS)).To(Succeed())
+		Expect(updatedTWS.Status.Targets).To(HaveLen(2))
+		ready := meta.FindStatusCondition(updatedTWS.Status.Conditions, "Ready")
+		Expect(ready).NotTo(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("Should reject selecting kinds the controller does not watch", func() {
+		fakeClient := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithRESTMapper(testRESTMapper()).Build()
+		_, err := selectTargetWorkloads(context.Background(), fakeClient, &kyklosv1alpha1.TargetSelector{
+			APIVersion: "apps/v1",
+			Kind:       "ReplicaSet",
+		}, "apps")
+		Expect(err).To(MatchError(ContainSubstring("not supported")))
+	})
+})
@@ -0,0 +1,228 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxCronSearch bounds how far ahead Next looks for an occurrence
+const maxCronSearch = 5 * 366 * 24 * time.Hour
+
+// maxCronOccurrences bounds the occurrences walked when chaining overlapping runs of a window
+const maxCronOccurrences = 10000
+
+// CronSchedule is a parsed five-field cron expression (minute hour day-of-month month day-of-week).
+// Unlike Vixie cron, a date must satisfy both day-of-month and day-of-week when both are restricted,
+// so "0 2 1-7 * MON" means the first Monday of the month rather than every Monday plus days 1-7.
+type CronSchedule struct {
+	minute, hour, dom, month, dow uint64
+}
+
+// cronField describes the allowed range and names of a cron field
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day-of-month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	cronDow = cronField{name: "day-of-week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// ParseCron parses a standard five-field cron expression.
+// Fields accept "*", values, names (JAN, MON), ranges ("1-5"), lists ("1,15") and steps ("*/15").
+func ParseCron(expr string) (*CronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields, got %d", expr, len(fields))
+	}
+
+	sched := &CronSchedule{}
+	var err error
+	if sched.minute, err = parseCronField(fields[0], cronMinute); err != nil {
+		return nil, err
+	}
+	if sched.hour, err = parseCronField(fields[1], cronHour); err != nil {
+		return nil, err
+	}
+	if sched.dom, err = parseCronField(fields[2], cronDom); err != nil {
+		return nil, err
+	}
+	if sched.month, err = parseCronField(fields[3], cronMonth); err != nil {
+		return nil, err
+	}
+	if sched.dow, err = parseCronField(fields[4], cronDow); err != nil {
+		return nil, err
+	}
+	// Sunday may be written as 0 or 7
+	if sched.dow&(1<<7) != 0 {
+		sched.dow |= 1
+	}
+	return sched, nil
+}
+
+// parseCronField parses one comma separated cron field into a bit set
+func parseCronField(field string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			rangePart = part[:i]
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid %s step in %q", f.name, part)
+			}
+		}
+
+		lo, hi := f.min, f.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if lo, err = parseCronValue(bounds[0], f); err != nil {
+				return 0, err
+			}
+			if hi, err = parseCronValue(bounds[1], f); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid %s range %q", f.name, rangePart)
+			}
+		default:
+			value, err := parseCronValue(rangePart, f)
+			if err != nil {
+				return 0, err
+			}
+			lo = value
+			if step == 1 {
+				hi = value
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// parseCronValue parses a single numeric or named cron value
+func parseCronValue(value string, f cronField) (int, error) {
+	if n, ok := f.names[strings.ToLower(value)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("invalid %s value %q", f.name, value)
+	}
+	return n, nil
+}
+
+// dayMatches reports whether the date of t satisfies the day-of-month and day-of-week fields
+func (s *CronSchedule) dayMatches(t time.Time) bool {
+	return s.dom&(1<<uint(t.Day())) != 0 && s.dow&(1<<uint(t.Weekday())) != 0
+}
+
+// Next returns the first occurrence strictly after t, in t's location.
+// The zero time is returned when there is no occurrence within five years.
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc).Add(time.Minute)
+	limit := t.Add(maxCronSearch)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			if !next.After(t) {
+				// The next wall-clock hour was repeated by a DST change
+				next = t.Add(time.Hour).Truncate(time.Minute)
+			}
+			t = next
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// parseCronWindow resolves a cron window to the occurrence covering now, or the next one.
+// Overlapping or back-to-back occurrences are chained into a single window.
+func parseCronWindow(ws WindowSpec, nowLocal time.Time) (*Window, error) {
+	if ws.Duration <= 0 {
+		return nil, fmt.Errorf("cron window %q requires a positive duration", ws.Name)
+	}
+	sched, err := ParseCron(ws.StartCron)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first occurrence that has not ended by now
+	start := sched.Next(nowLocal.Add(-ws.Duration))
+	if start.IsZero() {
+		return nil, fmt.Errorf("cron expression %q has no upcoming occurrence", ws.StartCron)
+	}
+	end := start.Add(ws.Duration)
+
+	// Extend the window while the next occurrence starts before it ends
+	last := start
+	for i := 0; i < maxCronOccurrences; i++ {
+		next := sched.Next(last)
+		if next.IsZero() || next.After(end) {
+			break
+		}
+		last = next
+		if next.Add(ws.Duration).After(end) {
+			end = next.Add(ws.Duration)
+		}
+	}
+
+	return &Window{
+		Start:       start,
+		End:         end,
+		Replicas:    ws.Replicas,
+		MaxReplicas: ws.MaxReplicas,
+		Name:        ws.Name,
+		Priority:    ws.Priority,
+	}, nil
+}
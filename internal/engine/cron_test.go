@@ -0,0 +1,246 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "Every minute", expr: "* * * * *"},
+		{name: "Steps, ranges and lists", expr: "*/15 9-17 1,15 * MON-FRI"},
+		{name: "Named months and days", expr: "0 2 * jan,DEC sun"},
+		{name: "Sunday as 7", expr: "0 0 * * 7"},
+		{name: "Too few fields", expr: "0 2 * *", wantErr: true},
+		{name: "Minute out of range", expr: "60 * * * *", wantErr: true},
+		{name: "Day of month zero", expr: "0 0 0 * *", wantErr: true},
+		{name: "Reversed range", expr: "0 17-9 * * *", wantErr: true},
+		{name: "Zero step", expr: "*/0 * * * *", wantErr: true},
+		{name: "Unknown name", expr: "0 0 * * MUN", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCron(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCron(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "Next quarter hour",
+			expr: "*/15 * * * *",
+			from: time.Date(2025, 3, 10, 10, 7, 30, 0, time.UTC),
+			want: time.Date(2025, 3, 10, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name: "Strictly after an occurrence",
+			expr: "*/15 * * * *",
+			from: time.Date(2025, 3, 10, 10, 15, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 10, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "First Monday of the month",
+			expr: "0 2 1-7 * MON",
+			from: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), // Tuesday; March 3 was the first Monday
+			want: time.Date(2025, 4, 7, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Restricted day of month only",
+			expr: "30 6 15 * *",
+			from: time.Date(2025, 3, 15, 7, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 4, 15, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "Leap day",
+			expr: "0 0 29 2 *",
+			from: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Weekend across year end",
+			expr: "0 9 * * SAT,SUN",
+			from: time.Date(2025, 12, 31, 12, 0, 0, 0, time.UTC), // Wednesday
+			want: time.Date(2026, 1, 3, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Skips the hour lost to DST",
+			expr: "30 2 * * *",
+			from: time.Date(2025, 3, 9, 0, 0, 0, 0, newYork), // 02:30 does not exist on March 9
+			want: time.Date(2025, 3, 10, 2, 30, 0, 0, newYork),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCron() error = %v", err)
+			}
+			if got := sched.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCronNextNoOccurrence(t *testing.T) {
+	sched, err := ParseCron("0 0 31 2 *")
+	if err != nil {
+		t.Fatalf("ParseCron() error = %v", err)
+	}
+	if got := sched.Next(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)); !got.IsZero() {
+		t.Errorf("Next() = %v, want zero time", got)
+	}
+}
+
+func TestCronWindows(t *testing.T) {
+	tests := []struct {
+		name             string
+		now              time.Time
+		windows          []WindowSpec
+		wantReplicas     int32
+		wantWindow       string
+		wantNextBoundary time.Time
+	}{
+		{
+			name: "Inside a quarter-hour window",
+			now:  time.Date(2025, 3, 10, 10, 17, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{StartCron: "*/15 * * * *", Duration: 5 * time.Minute, Replicas: 4, Name: "burst"},
+			},
+			wantReplicas:     4,
+			wantWindow:       "burst",
+			wantNextBoundary: time.Date(2025, 3, 10, 10, 20, 0, 0, time.UTC),
+		},
+		{
+			name: "Between quarter-hour windows",
+			now:  time.Date(2025, 3, 10, 10, 20, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{StartCron: "*/15 * * * *", Duration: 5 * time.Minute, Replicas: 4, Name: "burst"},
+			},
+			wantReplicas:     1,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2025, 3, 10, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "First Monday of the month is active",
+			now:  time.Date(2025, 3, 3, 3, 0, 0, 0, time.UTC), // Monday March 3
+			windows: []WindowSpec{
+				{StartCron: "0 2 1-7 * MON", Duration: 4 * time.Hour, Replicas: 12, Name: "monthly"},
+			},
+			wantReplicas:     12,
+			wantWindow:       "monthly",
+			wantNextBoundary: time.Date(2025, 3, 3, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Second Monday of the month does not match",
+			now:  time.Date(2025, 3, 10, 3, 0, 0, 0, time.UTC), // Monday March 10
+			windows: []WindowSpec{
+				{StartCron: "0 2 1-7 * MON", Duration: 4 * time.Hour, Replicas: 12, Name: "monthly"},
+			},
+			wantReplicas:     1,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2025, 4, 7, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Cross-midnight occurrence after midnight",
+			now:  time.Date(2025, 3, 11, 1, 0, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{StartCron: "0 22 * * MON", Duration: 6 * time.Hour, Replicas: 3, Name: "night"},
+			},
+			wantReplicas:     3,
+			wantWindow:       "night",
+			wantNextBoundary: time.Date(2025, 3, 11, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Overlapping occurrences merge",
+			now:  time.Date(2025, 3, 10, 10, 50, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{StartCron: "0 10-11 * * *", Duration: 90 * time.Minute, Replicas: 5, Name: "rolling"},
+			},
+			wantReplicas:     5,
+			wantWindow:       "rolling",
+			wantNextBoundary: time.Date(2025, 3, 10, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "Cron window overrides an earlier HH:MM window",
+			now:  time.Date(2025, 3, 10, 10, 2, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{Start: "09:00", End: "17:00", Replicas: 5, Name: "business-hours"},
+				{StartCron: "0 * * * *", Duration: 10 * time.Minute, Replicas: 8, Name: "top-of-hour"},
+			},
+			wantReplicas:     8,
+			wantWindow:       "top-of-hour",
+			wantNextBoundary: time.Date(2025, 3, 10, 10, 10, 0, 0, time.UTC),
+		},
+		{
+			name: "Invalid cron window is skipped",
+			now:  time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{StartCron: "0 25 * * *", Duration: time.Hour, Replicas: 8, Name: "broken"},
+			},
+			wantReplicas:     1,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ComputeEffectiveReplicas(Input{
+				Now:             tt.now,
+				Timezone:        "UTC",
+				Windows:         tt.windows,
+				DefaultReplicas: 1,
+			})
+			if err != nil {
+				t.Fatalf("ComputeEffectiveReplicas() error = %v", err)
+			}
+
+			if output.EffectiveReplicas != tt.wantReplicas {
+				t.Errorf("EffectiveReplicas = %v, want %v", output.EffectiveReplicas, tt.wantReplicas)
+			}
+			if output.CurrentWindow != tt.wantWindow {
+				t.Errorf("CurrentWindow = %v, want %v", output.CurrentWindow, tt.wantWindow)
+			}
+			if !output.NextBoundary.Equal(tt.wantNextBoundary) {
+				t.Errorf("NextBoundary = %v, want %v", output.NextBoundary, tt.wantNextBoundary)
+			}
+		})
+	}
+}
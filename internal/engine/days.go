@@ -0,0 +1,93 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// dayAliases maps the accepted day aliases, in lower case, to the days they stand for
+var dayAliases = map[string][]time.Weekday{
+	"weekdays": {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	"weekday":  {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	"weekends": {time.Saturday, time.Sunday},
+	"weekend":  {time.Saturday, time.Sunday},
+}
+
+// ExpandDays expands day specifications into full day names, in order and without duplicates.
+// A specification is a full or three-letter day name ("Monday", "Mon"), a range of them that may wrap
+// around the week ("Mon-Fri", "Fri-Mon"), or an alias ("weekdays", "weekends"), all ignoring case.
+func ExpandDays(specs []string) ([]string, error) {
+	if len(specs) == 0 {
+		return specs, nil
+	}
+	var days []string
+	seen := make(map[time.Weekday]bool)
+	for _, spec := range specs {
+		expanded, err := expandDay(strings.TrimSpace(spec))
+		if err != nil {
+			return nil, err
+		}
+		for _, day := range expanded {
+			if !seen[day] {
+				seen[day] = true
+				days = append(days, day.String())
+			}
+		}
+	}
+	return days, nil
+}
+
+// expandDay expands a single day specification
+func expandDay(spec string) ([]time.Weekday, error) {
+	if days, ok := dayAliases[strings.ToLower(spec)]; ok {
+		return days, nil
+	}
+	from, to, isRange := strings.Cut(spec, "-")
+	if !isRange {
+		day, ok := parseDayName(spec)
+		if !ok {
+			return nil, fmt.Errorf("invalid day '%s'", spec)
+		}
+		return []time.Weekday{day}, nil
+	}
+
+	first, ok := parseDayName(strings.TrimSpace(from))
+	last, okLast := parseDayName(strings.TrimSpace(to))
+	if !ok || !okLast {
+		return nil, fmt.Errorf("invalid day range '%s'", spec)
+	}
+	var days []time.Weekday
+	for day := first; ; day = (day + 1) % 7 {
+		days = append(days, day)
+		if day == last {
+			return days, nil
+		}
+	}
+}
+
+// parseDayName parses a full or three-letter day name, ignoring case
+func parseDayName(name string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(name, day.String()) || strings.EqualFold(name, day.String()[:3]) {
+			return day, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandDays(t *testing.T) {
+	weekdays := []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}
+
+	tests := []struct {
+		name    string
+		specs   []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "No days", specs: nil, want: nil},
+		{name: "Full names keep their order", specs: []string{"Sunday", "Monday"}, want: []string{"Sunday", "Monday"}},
+		{name: "Case is normalized", specs: []string{"monday", "FRIDAY"}, want: []string{"Monday", "Friday"}},
+		{name: "Abbreviations", specs: []string{"Sat", "sun"}, want: []string{"Saturday", "Sunday"}},
+		{name: "Range", specs: []string{"Mon-Fri"}, want: weekdays},
+		{name: "Range of full names", specs: []string{"Monday - Wednesday"}, want: []string{"Monday", "Tuesday", "Wednesday"}},
+		{name: "Range wrapping around the week", specs: []string{"Fri-Mon"}, want: []string{"Friday", "Saturday", "Sunday", "Monday"}},
+		{name: "Single-day range", specs: []string{"Wed-Wed"}, want: []string{"Wednesday"}},
+		{name: "Weekdays alias", specs: []string{"weekdays"}, want: weekdays},
+		{name: "Weekends alias", specs: []string{"Weekends"}, want: []string{"Saturday", "Sunday"}},
+		{name: "Duplicates are dropped", specs: []string{"weekdays", "Mon", "Sat-Sun", "Saturday"},
+			want: append(weekdays, "Saturday", "Sunday")},
+		{name: "Misspelled day", specs: []string{"Munday"}, wantErr: true},
+		{name: "Open range", specs: []string{"Mon-"}, wantErr: true},
+		{name: "Two-letter abbreviation", specs: []string{"Mo"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExpandDays(tt.specs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExpandDays() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExpandDays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
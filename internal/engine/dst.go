@@ -0,0 +1,69 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"time"
+)
+
+// DST policies for window starts that fall into a daylight saving gap
+const (
+	// DSTPolicyShiftForward starts the window at the end of the gap (default)
+	DSTPolicyShiftForward = "ShiftForward"
+	// DSTPolicySkip skips the occurrence of the window on that date
+	DSTPolicySkip = "Skip"
+)
+
+// localTime resolves a wall-clock time on a date in loc, normalizing out-of-range days as time.Date does.
+// A time repeated by a DST overlap resolves to its first instance. A time skipped by a DST gap resolves
+// to the end of the gap, the first instant after it, and is reported as skipped.
+func localTime(year int, month time.Month, day, hour, minute int, loc *time.Location) (time.Time, bool) {
+	want := time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	t := time.Date(year, month, day, hour, minute, 0, 0, loc)
+
+	got := wallClock(t)
+	switch {
+	case got.After(want):
+		// Normalized past the gap: the gap ends where the zone of t starts
+		start, _ := t.ZoneBounds()
+		return start, true
+	case got.Before(want):
+		// Normalized before the gap: the gap ends where the zone of t ends
+		_, end := t.ZoneBounds()
+		return end, true
+	}
+
+	// In an overlap, the first instance is in the zone preceding the one of t
+	start, _ := t.ZoneBounds()
+	if start.IsZero() {
+		return t, false
+	}
+	_, offset := t.Zone()
+	_, previousOffset := start.Add(-time.Nanosecond).Zone()
+	if previousOffset > offset {
+		first := t.Add(-time.Duration(previousOffset-offset) * time.Second)
+		if first.Before(start) && wallClock(first).Equal(want) {
+			return first, false
+		}
+	}
+	return t, false
+}
+
+// wallClock returns the wall-clock reading of t as the same reading in UTC
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// 2025 transitions used below:
+//   America/New_York:    gap 02:00-03:00 on March 9, overlap 01:00-02:00 on November 2
+//   Europe/Berlin:       gap 02:00-03:00 on March 30, overlap 02:00-03:00 on October 26
+//   Australia/Lord_Howe: overlap 01:30-02:00 on April 6, gap 02:00-02:30 on October 5 (30-minute shift)
+
+func TestLocalTime(t *testing.T) {
+	utc := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2025, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		timezone string
+		month    time.Month
+		day      int
+		hour     int
+		minute   int
+		want     time.Time
+		wantGap  bool
+	}{
+		{name: "New York before the gap", timezone: "America/New_York", month: 3, day: 9, hour: 1, minute: 59, want: utc(3, 9, 6, 59)},
+		{name: "New York start of the gap", timezone: "America/New_York", month: 3, day: 9, hour: 2, minute: 0, want: utc(3, 9, 7, 0), wantGap: true},
+		{name: "New York inside the gap", timezone: "America/New_York", month: 3, day: 9, hour: 2, minute: 30, want: utc(3, 9, 7, 0), wantGap: true},
+		{name: "New York end of the gap", timezone: "America/New_York", month: 3, day: 9, hour: 3, minute: 0, want: utc(3, 9, 7, 0)},
+		{name: "New York before the overlap", timezone: "America/New_York", month: 11, day: 2, hour: 0, minute: 59, want: utc(11, 2, 4, 59)},
+		{name: "New York start of the overlap", timezone: "America/New_York", month: 11, day: 2, hour: 1, minute: 0, want: utc(11, 2, 5, 0)},
+		{name: "New York inside the overlap", timezone: "America/New_York", month: 11, day: 2, hour: 1, minute: 30, want: utc(11, 2, 5, 30)},
+		{name: "New York after the overlap", timezone: "America/New_York", month: 11, day: 2, hour: 2, minute: 0, want: utc(11, 2, 7, 0)},
+		{name: "Berlin inside the gap", timezone: "Europe/Berlin", month: 3, day: 30, hour: 2, minute: 30, want: utc(3, 30, 1, 0), wantGap: true},
+		{name: "Berlin end of the gap", timezone: "Europe/Berlin", month: 3, day: 30, hour: 3, minute: 0, want: utc(3, 30, 1, 0)},
+		{name: "Berlin inside the overlap", timezone: "Europe/Berlin", month: 10, day: 26, hour: 2, minute: 30, want: utc(10, 26, 0, 30)},
+		{name: "Berlin after the overlap", timezone: "Europe/Berlin", month: 10, day: 26, hour: 3, minute: 0, want: utc(10, 26, 2, 0)},
+		{name: "Lord Howe inside the gap", timezone: "Australia/Lord_Howe", month: 10, day: 5, hour: 2, minute: 15, want: utc(10, 4, 15, 30), wantGap: true},
+		{name: "Lord Howe end of the gap", timezone: "Australia/Lord_Howe", month: 10, day: 5, hour: 2, minute: 30, want: utc(10, 4, 15, 30)},
+		{name: "Lord Howe start of the overlap", timezone: "Australia/Lord_Howe", month: 4, day: 6, hour: 1, minute: 30, want: utc(4, 5, 14, 30)},
+		{name: "Lord Howe inside the overlap", timezone: "Australia/Lord_Howe", month: 4, day: 6, hour: 1, minute: 45, want: utc(4, 5, 14, 45)},
+		{name: "Lord Howe after the overlap", timezone: "Australia/Lord_Howe", month: 4, day: 6, hour: 2, minute: 0, want: utc(4, 5, 15, 30)},
+		{name: "Santiago midnight in the gap", timezone: "America/Santiago", month: 9, day: 7, hour: 0, minute: 0, want: utc(9, 7, 4, 0), wantGap: true},
+		{name: "Day past the end of the month", timezone: "America/New_York", month: 1, day: 32, hour: 0, minute: 0, want: utc(2, 1, 5, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.timezone)
+			if err != nil {
+				t.Fatalf("LoadLocation() error = %v", err)
+			}
+			got, gap := localTime(2025, tt.month, tt.day, tt.hour, tt.minute, loc)
+			if !got.Equal(tt.want) {
+				t.Errorf("localTime() = %v, want %v", got.UTC(), tt.want)
+			}
+			if gap != tt.wantGap {
+				t.Errorf("localTime() gap = %v, want %v", gap, tt.wantGap)
+			}
+		})
+	}
+}
+
+func TestDSTWindows(t *testing.T) {
+	utc := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2025, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name             string
+		timezone         string
+		dstPolicy        string
+		now              time.Time
+		window           WindowSpec
+		wantReplicas     int32
+		wantNextBoundary time.Time
+	}{
+		// America/New_York
+		{
+			name:             "New York start in the gap shifts forward",
+			timezone:         "America/New_York",
+			now:              utc(3, 9, 6, 59),
+			window:           WindowSpec{Start: "02:30", End: "04:00", Replicas: 5},
+			wantReplicas:     1,
+			wantNextBoundary: utc(3, 9, 7, 0),
+		},
+		{
+			name:             "New York shifted window is active after the gap",
+			timezone:         "America/New_York",
+			dstPolicy:        DSTPolicyShiftForward,
+			now:              utc(3, 9, 7, 0),
+			window:           WindowSpec{Start: "02:30", End: "04:00", Replicas: 5},
+			wantReplicas:     5,
+			wantNextBoundary: utc(3, 9, 8, 0),
+		},
+		{
+			name:             "New York start in the gap is skipped",
+			timezone:         "America/New_York",
+			dstPolicy:        DSTPolicySkip,
+			now:              utc(3, 9, 7, 0),
+			window:           WindowSpec{Start: "02:30", End: "04:00", Replicas: 5},
+			wantReplicas:     1,
+			wantNextBoundary: utc(3, 10, 6, 30),
+		},
+		{
+			name:             "New York end in the gap moves to the end of the gap",
+			timezone:         "America/New_York",
+			dstPolicy:        DSTPolicySkip,
+			now:              utc(3, 9, 6, 30),
+			window:           WindowSpec{Start: "01:00", End: "02:30", Replicas: 5},
+			wantReplicas:     5,
+			wantNextBoundary: utc(3, 9, 7, 0),
+		},
+		{
+			name:             "New York window across the gap is an hour shorter",
+			timezone:         "America/New_York",
+			now:              utc(3, 9, 6, 30),
+			window:           WindowSpec{Start: "01:00", End: "04:00", Replicas: 5},
+			wantReplicas:     5,
+			wantNextBoundary: utc(3, 9, 8, 0),
+		},
+		{
+			name:             "New York overlap uses the first instance of the start and end",
+			timezone:         "America/New_York",
+			now:              utc(11, 2, 6, 15), // 01:15 EST, the second 01:15
+			window:           WindowSpec{Start: "01:00", End: "01:30", Replicas: 5},
+			wantReplicas:     1,
+			wantNextBoundary: utc(11, 3, 6, 0),
+		},
+		{
+			name:             "New York window across the overlap is an hour longer",
+			timezone:         "America/New_York",
+			now:              utc(11, 2, 6, 45), // 01:45 EST
+			window:           WindowSpec{Start: "01:30", End: "02:00", Replicas: 5},
+			wantReplicas:     5,
+			wantNextBoundary: utc(11, 2, 7, 0),
+		},
+		{
+			name:             "New York cross-midnight window over the overlap",
+			timezone:         "America/New_York",
+			now:              utc(11, 2, 10, 30),
+			window:           WindowSpec{Start: "22:00", End: "06:00", Replicas: 5},
+			wantReplicas:     5,
+			wantNextBoundary: utc(11, 2, 11, 0),
+		},
+		// Europe/Berlin
+		{
+			name:             "Berlin window inside the gap is empty",
+			timezone:         "Europe/Berlin",
+			now:              utc(3, 30, 0, 30),
+			window:           WindowSpec{Start: "02:00", End: "02:45", Replicas: 5},
+			wantReplicas:     1,
+			wantNextBoundary: utc(3, 30, 1, 0),
+		},
+		{
+			name:             "Berlin window inside the gap is skipped",
+			timezone:         "Europe/Berlin",
+			dstPolicy:        DSTPolicySkip,
+			now:              utc(3, 30, 0, 30),
+			window:           WindowSpec{Start: "02:00", End: "02:45", Replicas: 5},
+			wantReplicas:     1,
+			wantNextBoundary: utc(3, 31, 0, 0),
+		},
+		{
+			name:             "Berlin overlap starts at the first instance",
+			timezone:         "Europe/Berlin",
+			now:              utc(10, 26, 0, 30),
+			window:           WindowSpec{Start: "02:30", End: "03:00", Replicas: 5},
+			wantReplicas:     5,
+			wantNextBoundary: utc(10, 26, 2, 0),
+		},
+		{
+			name:             "Berlin overlap second instance is still in the window",
+			timezone:         "Europe/Berlin",
+			now:              utc(10, 26, 1, 45), // 02:45 CET
+			window:           WindowSpec{Start: "02:30", End: "03:00", Replicas: 5},
+			wantReplicas:     5,
+			wantNextBoundary: utc(10, 26, 2, 0),
+		},
+		// Australia/Lord_Howe
+		{
+			name:             "Lord Howe start in the half-hour gap shifts forward",
+			timezone:         "Australia/Lord_Howe",
+			now:              utc(10, 4, 15, 30),
+			window:           WindowSpec{Start: "02:00", End: "05:00", Replicas: 5},
+			wantReplicas:     5,
+			wantNextBoundary: utc(10, 4, 18, 0),
+		},
+		{
+			name:             "Lord Howe start in the half-hour gap is skipped",
+			timezone:         "Australia/Lord_Howe",
+			dstPolicy:        DSTPolicySkip,
+			now:              utc(10, 4, 16, 0),
+			window:           WindowSpec{Start: "02:10", End: "05:00", Replicas: 5},
+			wantReplicas:     1,
+			wantNextBoundary: utc(10, 5, 15, 10),
+		},
+		{
+			name:             "Lord Howe start at the end of the gap is not skipped",
+			timezone:         "Australia/Lord_Howe",
+			dstPolicy:        DSTPolicySkip,
+			now:              utc(10, 4, 15, 30),
+			window:           WindowSpec{Start: "02:30", End: "05:00", Replicas: 5},
+			wantReplicas:     5,
+			wantNextBoundary: utc(10, 4, 18, 0),
+		},
+		{
+			name:             "Lord Howe window across the half-hour overlap",
+			timezone:         "Australia/Lord_Howe",
+			now:              utc(4, 5, 15, 20), // the second 01:50
+			window:           WindowSpec{Start: "01:45", End: "02:15", Replicas: 5},
+			wantReplicas:     5,
+			wantNextBoundary: utc(4, 5, 15, 45),
+		},
+		// One-off windows always shift forward
+		{
+			name:             "One-off start in the gap shifts forward",
+			timezone:         "America/New_York",
+			dstPolicy:        DSTPolicySkip,
+			now:              utc(3, 9, 7, 0),
+			window:           WindowSpec{StartAt: "2025-03-09T02:30", EndAt: "2025-03-09T04:00", Replicas: 5, Name: "launch"},
+			wantReplicas:     5,
+			wantNextBoundary: utc(3, 9, 8, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ComputeEffectiveReplicas(Input{
+				Now:             tt.now,
+				Timezone:        tt.timezone,
+				Windows:         []WindowSpec{tt.window},
+				DefaultReplicas: 1,
+				DSTPolicy:       tt.dstPolicy,
+			})
+			if err != nil {
+				t.Fatalf("ComputeEffectiveReplicas() error = %v", err)
+			}
+			if output.EffectiveReplicas != tt.wantReplicas {
+				t.Errorf("EffectiveReplicas = %v, want %v", output.EffectiveReplicas, tt.wantReplicas)
+			}
+			if !output.NextBoundary.Equal(tt.wantNextBoundary) {
+				t.Errorf("NextBoundary = %v, want %v", output.NextBoundary.UTC(), tt.wantNextBoundary)
+			}
+		})
+	}
+}
+
+// TestDSTBoundarySweep steps through every DST transition day of 2025 in each zone and checks that
+// the replicas only change at a previously reported boundary and that every boundary lies ahead
+func TestDSTBoundarySweep(t *testing.T) {
+	days := map[string][]time.Time{
+		"America/New_York":    {time.Date(2025, 3, 8, 12, 0, 0, 0, time.UTC), time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)},
+		"Europe/Berlin":       {time.Date(2025, 3, 29, 12, 0, 0, 0, time.UTC), time.Date(2025, 10, 25, 12, 0, 0, 0, time.UTC)},
+		"Australia/Lord_Howe": {time.Date(2025, 4, 5, 0, 0, 0, 0, time.UTC), time.Date(2025, 10, 4, 0, 0, 0, 0, time.UTC)},
+	}
+
+	// Windows starting and ending on every quarter hour around the transitions
+	var windows []WindowSpec
+	for minute := 0; minute < 4*60; minute += 15 {
+		start := fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+		end := fmt.Sprintf("%02d:%02d", (minute+45)/60, (minute+45)%60)
+		windows = append(windows, WindowSpec{Start: start, End: end, Replicas: int32(minute/15 + 2), Name: start})
+	}
+
+	for timezone, starts := range days {
+		for _, policy := range []string{DSTPolicyShiftForward, DSTPolicySkip} {
+			for _, from := range starts {
+				for _, ws := range windows {
+					t.Run(fmt.Sprintf("%s/%s/%s/%s", timezone, policy, from.Format(DateLayout), ws.Name), func(t *testing.T) {
+						var previous Output
+						for now := from; now.Before(from.Add(36 * time.Hour)); now = now.Add(5 * time.Minute) {
+							output, err := ComputeEffectiveReplicas(Input{
+								Now:             now,
+								Timezone:        timezone,
+								Windows:         []WindowSpec{ws},
+								DefaultReplicas: 1,
+								DSTPolicy:       policy,
+							})
+							if err != nil {
+								t.Fatalf("ComputeEffectiveReplicas() error = %v", err)
+							}
+							if !output.NextBoundary.After(now) {
+								t.Fatalf("at %v: NextBoundary %v is not after now", now, output.NextBoundary.UTC())
+							}
+							if !previous.NextBoundary.IsZero() && now.Before(previous.NextBoundary) &&
+								output.EffectiveReplicas != previous.EffectiveReplicas {
+								t.Fatalf("at %v: replicas changed from %d to %d before the boundary %v",
+									now, previous.EffectiveReplicas, output.EffectiveReplicas, previous.NextBoundary.UTC())
+							}
+							previous = output
+						}
+					})
+				}
+			}
+		}
+	}
+}
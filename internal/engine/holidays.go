@@ -0,0 +1,182 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// monthDayLayout is the format of annual holiday keys
+const monthDayLayout = "01-02"
+
+// holidayRangeSeparator separates the first and last date of a holiday range
+const holidayRangeSeparator = ".."
+
+// holidayOrdinals maps the ordinals of weekday rule keys to WeekdayOccurrence.Nth
+var holidayOrdinals = map[string]int{"1st": 1, "2nd": 2, "3rd": 3, "4th": 4, "5th": 5, "last": -1}
+
+// holidayKind orders the kinds of holiday rules; when several match a date, the most specific wins
+type holidayKind int
+
+const (
+	holidayDate holidayKind = iota
+	holidayRange
+	holidayAnnual
+	holidayWeekday
+)
+
+// holiday is a parsed holiday rule
+type holiday struct {
+	kind       holidayKind
+	key        string
+	name       string
+	from, to   string             // YYYY-MM-DD, inclusive; dates and ranges
+	monthDay   string             // MM-DD; annual holidays
+	month      time.Month         // Weekday rules
+	occurrence *WeekdayOccurrence // Weekday rules
+}
+
+// HolidayCalendar holds the holidays of a holiday ConfigMap. A nil calendar has no holidays.
+type HolidayCalendar struct {
+	holidays []holiday
+	events   []icsEvent
+}
+
+// ParseHolidays parses the data of a holiday ConfigMap. Each key is a rule, and its value names the holiday:
+//   - "2025-12-25": a single date
+//   - "12-25": the same date every year
+//   - "2025-12-22..2025-12-31": every date of a range, inclusive
+//   - "11-4th-thu", "05-last-mon": the nth weekday of a month every year (1st to 5th, or last)
+//
+// Keys ending in .ics instead hold an iCalendar calendar, whose all-day and timed VEVENTs are holidays on every
+// day they overlap, named by their SUMMARY; they apply after the other keys.
+// The calendar holds every valid key and event, even when an error reports invalid ones.
+func ParseHolidays(data map[string]string) (*HolidayCalendar, error) {
+	calendar := &HolidayCalendar{}
+	var errs []error
+	var icsKeys []string
+	for key, value := range data {
+		if strings.HasSuffix(key, ICSKeySuffix) {
+			icsKeys = append(icsKeys, key)
+			continue
+		}
+		h, err := parseHoliday(key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		h.name = strings.TrimSpace(value)
+		if h.name == "" {
+			h.name = key
+		}
+		calendar.holidays = append(calendar.holidays, h)
+	}
+
+	sort.Slice(calendar.holidays, func(i, j int) bool {
+		a, b := calendar.holidays[i], calendar.holidays[j]
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		return a.key < b.key
+	})
+	sort.Strings(icsKeys)
+	for _, key := range icsKeys {
+		events, err := parseICS(data[key])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid holiday calendar '%s': %w", key, err))
+		}
+		calendar.events = append(calendar.events, events...)
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return calendar, errors.Join(errs...)
+}
+
+// parseHoliday parses a holiday key
+func parseHoliday(key string) (holiday, error) {
+	if from, to, isRange := strings.Cut(key, holidayRangeSeparator); isRange {
+		first, errFirst := time.Parse(DateLayout, from)
+		last, errLast := time.Parse(DateLayout, to)
+		if errFirst != nil || errLast != nil || last.Before(first) {
+			return holiday{}, fmt.Errorf("invalid holiday range '%s'", key)
+		}
+		return holiday{kind: holidayRange, key: key, from: from, to: to}, nil
+	}
+	if _, err := time.Parse(DateLayout, key); err == nil {
+		return holiday{kind: holidayDate, key: key, from: key, to: key}, nil
+	}
+	if _, err := time.Parse(monthDayLayout, key); err == nil {
+		return holiday{kind: holidayAnnual, key: key, monthDay: key}, nil
+	}
+
+	parts := strings.Split(key, "-")
+	if len(parts) == 3 {
+		month, err := strconv.Atoi(parts[0])
+		nth, okNth := holidayOrdinals[strings.ToLower(parts[1])]
+		day, okDay := parseDayName(parts[2])
+		if err == nil && len(parts[0]) == 2 && month >= 1 && month <= 12 && okNth && okDay {
+			return holiday{
+				kind:       holidayWeekday,
+				key:        key,
+				month:      time.Month(month),
+				occurrence: &WeekdayOccurrence{Weekday: day.String(), Nth: nth},
+			}, nil
+		}
+	}
+	return holiday{}, fmt.Errorf("invalid holiday '%s'", key)
+}
+
+// Holiday returns the name of the holiday on a date, using the date's own calendar day and location
+func (c *HolidayCalendar) Holiday(date time.Time) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	day := date.Format(DateLayout)
+	for _, h := range c.holidays {
+		if h.matches(date, day) {
+			return h.name, true
+		}
+	}
+	for _, event := range c.events {
+		if event.overlaps(date) {
+			return event.name, true
+		}
+	}
+	return "", false
+}
+
+// IsHoliday reports whether a date is a holiday
+func (c *HolidayCalendar) IsHoliday(date time.Time) bool {
+	_, ok := c.Holiday(date)
+	return ok
+}
+
+// matches reports whether the holiday falls on a date, given as a time and as YYYY-MM-DD
+func (h holiday) matches(date time.Time, day string) bool {
+	switch h.kind {
+	case holidayAnnual:
+		return date.Format(monthDayLayout) == h.monthDay
+	case holidayWeekday:
+		return date.Month() == h.month && isWeekdayOccurrenceMatch(h.occurrence, date)
+	default:
+		return day >= h.from && day <= h.to
+	}
+}
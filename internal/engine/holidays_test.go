@@ -0,0 +1,109 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHolidayCalendar(t *testing.T) {
+	data := map[string]string{
+		"2025-07-04":             "Independence Day",
+		"12-25":                  "Christmas Day",
+		"02-29":                  "Leap Day",
+		"2025-12-22..2025-12-31": "Winter Shutdown",
+		"11-4th-thu":             "Thanksgiving",
+		"05-last-mon":            "Memorial Day",
+		"2026-01-02":             "",
+	}
+	calendar, err := ParseHolidays(data)
+	if err != nil {
+		t.Fatalf("ParseHolidays() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		date     string
+		wantName string
+		wantOK   bool
+	}{
+		{name: "Single date", date: "2025-07-04", wantName: "Independence Day", wantOK: true},
+		{name: "Single date in another year", date: "2026-07-04", wantOK: false},
+		{name: "Annual date", date: "2031-12-25", wantName: "Christmas Day", wantOK: true},
+		{name: "Annual leap day", date: "2028-02-29", wantName: "Leap Day", wantOK: true},
+		{name: "First day of range", date: "2025-12-22", wantName: "Winter Shutdown", wantOK: true},
+		{name: "Last day of range", date: "2025-12-31", wantName: "Winter Shutdown", wantOK: true},
+		{name: "Day after range", date: "2026-01-01", wantOK: false},
+		{name: "Range beats annual date", date: "2025-12-25", wantName: "Winter Shutdown", wantOK: true},
+		{name: "Fourth Thursday of November", date: "2025-11-27", wantName: "Thanksgiving", wantOK: true},
+		{name: "Day after Thanksgiving", date: "2025-11-28", wantOK: false},
+		{name: "Fourth Thursday of November in a later year", date: "2026-11-26", wantName: "Thanksgiving", wantOK: true},
+		{name: "Last Monday of May", date: "2025-05-26", wantName: "Memorial Day", wantOK: true},
+		{name: "Monday of May that is not the last", date: "2026-05-18", wantOK: false},
+		{name: "Empty value is named after its key", date: "2026-01-02", wantName: "2026-01-02", wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, err := time.Parse(DateLayout, tt.date)
+			if err != nil {
+				t.Fatal(err)
+			}
+			name, ok := calendar.Holiday(date)
+			if ok != tt.wantOK || name != tt.wantName {
+				t.Errorf("Holiday(%s) = %q, %v, want %q, %v", tt.date, name, ok, tt.wantName, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseHolidaysInvalidKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{name: "Not a date", key: "christmas"},
+		{name: "Invalid date", key: "2025-02-30"},
+		{name: "Invalid annual date", key: "13-01"},
+		{name: "Backwards range", key: "2025-12-31..2025-12-22"},
+		{name: "Open range", key: "2025-12-22.."},
+		{name: "Unknown ordinal", key: "11-6th-thu"},
+		{name: "Unknown weekday", key: "11-4th-thx"},
+		{name: "Single-digit month", key: "5-last-mon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calendar, err := ParseHolidays(map[string]string{tt.key: "Holiday", "12-25": "Christmas Day"})
+			if err == nil {
+				t.Fatalf("ParseHolidays() expected an error for %q", tt.key)
+			}
+			// The valid keys still apply
+			if !calendar.IsHoliday(time.Date(2025, time.December, 25, 0, 0, 0, 0, time.UTC)) {
+				t.Errorf("IsHoliday() = false for a valid key next to an invalid one")
+			}
+		})
+	}
+}
+
+func TestNilHolidayCalendar(t *testing.T) {
+	var calendar *HolidayCalendar
+	if calendar.IsHoliday(time.Now()) {
+		t.Errorf("IsHoliday() = true for a nil calendar")
+	}
+}
@@ -0,0 +1,556 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ICSKeySuffix marks the holiday ConfigMap keys holding an iCalendar (RFC 5545) calendar
+const ICSKeySuffix = ".ics"
+
+// iCalendar date and date-time formats
+const (
+	icsDateLayout     = "20060102"
+	icsDateTimeLayout = "20060102T150405"
+)
+
+// maxICSOccurrences bounds the occurrences expanded for an RRULE with a COUNT
+const maxICSOccurrences = 1000
+
+// icsDurationPattern matches iCalendar durations such as P1D, PT1H30M or P1W
+var icsDurationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// icsWeekdays maps iCalendar weekday codes to weekdays
+var icsWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// icsEvent is a VEVENT of a holiday calendar.
+// Dates are civil dates, held as midnight UTC; wall times are held in UTC with their location alongside.
+type icsEvent struct {
+	name    string
+	allDay  bool
+	start   time.Time      // DTSTART wall time
+	loc     *time.Location // Location of DTSTART; nil for all-day and floating events, which use the schedule's
+	length  time.Duration  // DTEND - DTSTART, or DURATION
+	rule    *icsRule
+	exdates map[string]bool // Excluded occurrence starts, keyed by exdateKey
+}
+
+// icsRule is the supported subset of an RRULE
+type icsRule struct {
+	freq       string
+	interval   int
+	until      *time.Time     // Last occurrence start, inclusive; a civil date when untilDate
+	untilLoc   *time.Location // Location of until; nil when it is a civil date or floating
+	untilDate  bool
+	byMonth    []time.Month
+	byMonthDay []int
+	byDay      []icsWeekday
+	weekStart  time.Weekday
+	// occurrences holds the expanded occurrence dates of a rule with a COUNT
+	occurrences map[string]bool
+}
+
+// icsWeekday is a BYDAY entry such as MO, 4TH or -1FR
+type icsWeekday struct {
+	day time.Weekday
+	nth int // 0 for every such weekday
+}
+
+// icsProperty is a content line of an iCalendar object
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICS parses the VEVENTs of an iCalendar object. Invalid events are reported in the error
+// and left out; the valid ones are returned alongside.
+func parseICS(data string) ([]icsEvent, error) {
+	var events []icsEvent
+	var errs []error
+	var properties []icsProperty
+	inEvent := false
+	depth := 0 // Components nested in the VEVENT, such as VALARM
+
+	for _, line := range unfoldICS(data) {
+		property, err := parseICSProperty(line)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		switch {
+		case property.name == "BEGIN" && strings.EqualFold(property.value, "VEVENT") && !inEvent:
+			inEvent, properties = true, nil
+		case !inEvent:
+			continue
+		case property.name == "BEGIN":
+			depth++
+		case property.name == "END" && depth > 0:
+			depth--
+		case property.name == "END" && strings.EqualFold(property.value, "VEVENT"):
+			inEvent = false
+			event, ok, err := buildICSEvent(properties)
+			if err != nil {
+				errs = append(errs, err)
+			} else if ok {
+				events = append(events, event)
+			}
+		case depth == 0:
+			properties = append(properties, property)
+		}
+	}
+	if inEvent {
+		errs = append(errs, errors.New("VEVENT is not terminated by END:VEVENT"))
+	}
+	return events, errors.Join(errs...)
+}
+
+// unfoldICS splits an iCalendar object into content lines, joining folded lines
+func unfoldICS(data string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, strings.TrimRight(line, "\r"))
+		}
+	}
+	return lines
+}
+
+// parseICSProperty parses a content line such as DTSTART;TZID="Europe/Berlin":20251224T120000
+func parseICSProperty(line string) (icsProperty, error) {
+	quoted := false
+	for i, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ':' && !quoted:
+			segments := strings.Split(line[:i], ";")
+			property := icsProperty{name: strings.ToUpper(segments[0]), params: map[string]string{}, value: line[i+1:]}
+			for _, segment := range segments[1:] {
+				name, value, _ := strings.Cut(segment, "=")
+				property.params[strings.ToUpper(name)] = strings.Trim(value, `"`)
+			}
+			return property, nil
+		}
+	}
+	return icsProperty{}, fmt.Errorf("invalid iCalendar line '%s'", line)
+}
+
+// buildICSEvent builds an event from the properties of a VEVENT; cancelled events are skipped
+func buildICSEvent(properties []icsProperty) (icsEvent, bool, error) {
+	event := icsEvent{name: "Holiday"}
+	var start, end, duration, rule *icsProperty
+	var exdates []icsProperty
+	for i := range properties {
+		property := &properties[i]
+		switch property.name {
+		case "SUMMARY":
+			event.name = unescapeICSText(property.value)
+		case "DTSTART":
+			start = property
+		case "DTEND":
+			end = property
+		case "DURATION":
+			duration = property
+		case "RRULE":
+			rule = property
+		case "EXDATE":
+			exdates = append(exdates, *property)
+		case "STATUS":
+			if strings.EqualFold(property.value, "CANCELLED") {
+				return icsEvent{}, false, nil
+			}
+		}
+	}
+	if start == nil {
+		return icsEvent{}, false, fmt.Errorf("event '%s' has no DTSTART", event.name)
+	}
+
+	var err error
+	if event.start, event.loc, event.allDay, err = parseICSTime(*start); err != nil {
+		return icsEvent{}, false, fmt.Errorf("event '%s' has %w", event.name, err)
+	}
+	switch {
+	case end != nil:
+		endTime, endLoc, endAllDay, err := parseICSTime(*end)
+		if err != nil {
+			return icsEvent{}, false, fmt.Errorf("event '%s' has %w", event.name, err)
+		}
+		if endAllDay != event.allDay {
+			return icsEvent{}, false, fmt.Errorf("event '%s' mixes a date and a date-time in DTSTART and DTEND", event.name)
+		}
+		event.length = icsInstant(endTime, endLoc, time.UTC).Sub(icsInstant(event.start, event.loc, time.UTC))
+	case duration != nil:
+		if event.length, err = parseICSDuration(duration.value); err != nil {
+			return icsEvent{}, false, fmt.Errorf("event '%s' has %w", event.name, err)
+		}
+	case event.allDay:
+		event.length = 24 * time.Hour
+	}
+	if event.length < 0 || (event.allDay && (event.length == 0 || event.length%(24*time.Hour) != 0)) {
+		return icsEvent{}, false, fmt.Errorf("event '%s' has an invalid end", event.name)
+	}
+
+	if rule != nil {
+		if event.rule, err = parseICSRule(rule.value, event); err != nil {
+			return icsEvent{}, false, fmt.Errorf("event '%s' has %w", event.name, err)
+		}
+	}
+	for _, exdate := range exdates {
+		for _, value := range strings.Split(exdate.value, ",") {
+			excluded, loc, allDay, err := parseICSTime(icsProperty{params: exdate.params, value: value})
+			if err != nil {
+				return icsEvent{}, false, fmt.Errorf("event '%s' has %w", event.name, err)
+			}
+			if event.exdates == nil {
+				event.exdates = make(map[string]bool)
+			}
+			event.exdates[event.exdateKey(excluded, loc, allDay)] = true
+		}
+	}
+	return event, true, nil
+}
+
+// parseICSTime parses a DATE or DATE-TIME value, returning its wall time in UTC, its location
+// (nil when floating or a date) and whether it is a date
+func parseICSTime(property icsProperty) (time.Time, *time.Location, bool, error) {
+	value := strings.TrimSpace(property.value)
+	if property.params["VALUE"] == "DATE" || len(value) == len(icsDateLayout) {
+		date, err := time.Parse(icsDateLayout, value)
+		if err != nil {
+			return time.Time{}, nil, false, fmt.Errorf("invalid date '%s'", value)
+		}
+		return date, nil, true, nil
+	}
+
+	var loc *time.Location
+	if utcValue, ok := strings.CutSuffix(value, "Z"); ok {
+		value, loc = utcValue, time.UTC
+	} else if tzid := property.params["TZID"]; tzid != "" {
+		var err error
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, nil, false, fmt.Errorf("invalid TZID '%s'", tzid)
+		}
+	}
+	wall, err := time.Parse(icsDateTimeLayout, value)
+	if err != nil {
+		return time.Time{}, nil, false, fmt.Errorf("invalid date-time '%s'", property.value)
+	}
+	return wall, loc, false, nil
+}
+
+// parseICSDuration parses an iCalendar duration such as P1D or PT4H
+func parseICSDuration(value string) (time.Duration, error) {
+	match := icsDurationPattern.FindStringSubmatch(value)
+	if match == nil || strings.Join(match[2:], "") == "" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("invalid duration '%s'", value)
+	}
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var duration time.Duration
+	for i, unit := range units {
+		if match[i+2] != "" {
+			n, _ := strconv.Atoi(match[i+2])
+			duration += time.Duration(n) * unit
+		}
+	}
+	if match[1] == "-" {
+		duration = -duration
+	}
+	return duration, nil
+}
+
+// parseICSRule parses the supported subset of an RRULE: FREQ (DAILY, WEEKLY, MONTHLY or YEARLY),
+// INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY, BYDAY and WKST
+func parseICSRule(value string, event icsEvent) (*icsRule, error) {
+	rule := &icsRule{interval: 1, weekStart: time.Monday}
+	count := 0
+	for _, part := range strings.Split(value, ";") {
+		name, v, _ := strings.Cut(part, "=")
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			rule.freq = strings.ToUpper(v)
+		case "INTERVAL":
+			if rule.interval, err = strconv.Atoi(v); err == nil && rule.interval < 1 {
+				err = errors.New("interval must be positive")
+			}
+		case "COUNT":
+			if count, err = strconv.Atoi(v); err == nil && count < 1 {
+				err = errors.New("count must be positive")
+			}
+		case "UNTIL":
+			var until time.Time
+			until, rule.untilLoc, rule.untilDate, err = parseICSTime(icsProperty{value: v})
+			rule.until = &until
+		case "BYMONTH":
+			err = parseICSList(v, func(n int) bool {
+				rule.byMonth = append(rule.byMonth, time.Month(n))
+				return n >= 1 && n <= 12
+			})
+		case "BYMONTHDAY":
+			err = parseICSList(v, func(n int) bool {
+				rule.byMonthDay = append(rule.byMonthDay, n)
+				return n != 0 && n >= -31 && n <= 31
+			})
+		case "BYDAY":
+			for _, entry := range strings.Split(v, ",") {
+				day, ok := icsWeekdays[strings.ToUpper(entry[max(0, len(entry)-2):])]
+				nth := 0
+				if prefix := entry[:max(0, len(entry)-2)]; prefix != "" {
+					nth, err = strconv.Atoi(prefix)
+					ok = ok && err == nil && nth != 0 && nth >= -53 && nth <= 53
+				}
+				if !ok {
+					return nil, fmt.Errorf("invalid RRULE BYDAY '%s'", entry)
+				}
+				rule.byDay = append(rule.byDay, icsWeekday{day: day, nth: nth})
+			}
+		case "WKST":
+			var ok bool
+			if rule.weekStart, ok = icsWeekdays[strings.ToUpper(v)]; !ok {
+				err = errors.New("invalid weekday")
+			}
+		default:
+			return nil, fmt.Errorf("unsupported RRULE part '%s'", part)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid RRULE part '%s': %w", part, err)
+		}
+	}
+
+	switch rule.freq {
+	case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+	default:
+		return nil, fmt.Errorf("unsupported RRULE frequency '%s'", rule.freq)
+	}
+	if count > 0 && rule.until != nil {
+		return nil, errors.New("RRULE with both COUNT and UNTIL")
+	}
+	for _, weekday := range rule.byDay {
+		if weekday.nth != 0 && rule.freq != "MONTHLY" && rule.freq != "YEARLY" {
+			return nil, fmt.Errorf("RRULE BYDAY ordinal with frequency %s", rule.freq)
+		}
+	}
+
+	// Expand the occurrences of a rule with a COUNT, so later dates need not count from DTSTART
+	if count > 0 {
+		rule.occurrences = make(map[string]bool)
+		first := civilDate(event.start)
+		for date := first; len(rule.occurrences) < min(count, maxICSOccurrences); date = date.AddDate(0, 0, 1) {
+			if date.Sub(first) > 100*366*24*time.Hour {
+				break
+			}
+			if rule.matches(first, date) {
+				rule.occurrences[date.Format(icsDateLayout)] = true
+			}
+		}
+	}
+	return rule, nil
+}
+
+// parseICSList parses a comma-separated list of integers, each accepted by add
+func parseICSList(value string, add func(n int) bool) error {
+	for _, entry := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(entry)
+		if err != nil || !add(n) {
+			return fmt.Errorf("invalid value '%s'", entry)
+		}
+	}
+	return nil
+}
+
+// unescapeICSText unescapes an iCalendar TEXT value
+func unescapeICSText(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
+
+// matches reports whether a civil date is an occurrence date of the rule, starting from the civil date first.
+// UNTIL and COUNT are not considered.
+func (r *icsRule) matches(first, date time.Time) bool {
+	if date.Before(first) {
+		return false
+	}
+
+	switch r.freq {
+	case "DAILY":
+		if int(date.Sub(first).Hours()/24)%r.interval != 0 {
+			return false
+		}
+	case "WEEKLY":
+		weeks := int(weekStartOf(date, r.weekStart).Sub(weekStartOf(first, r.weekStart)).Hours() / (24 * 7))
+		if weeks%r.interval != 0 {
+			return false
+		}
+		if len(r.byDay) == 0 && date.Weekday() != first.Weekday() {
+			return false
+		}
+	case "MONTHLY":
+		if ((date.Year()-first.Year())*12+int(date.Month()-first.Month()))%r.interval != 0 {
+			return false
+		}
+	case "YEARLY":
+		if (date.Year()-first.Year())%r.interval != 0 {
+			return false
+		}
+		if len(r.byMonth) == 0 && len(r.byMonthDay) == 0 && len(r.byDay) == 0 && date.Month() != first.Month() {
+			return false
+		}
+	}
+
+	if len(r.byMonth) > 0 && !slices.Contains(r.byMonth, date.Month()) {
+		return false
+	}
+	if len(r.byMonthDay) > 0 {
+		if !isDayOfMonthMatch(r.byMonthDay, date, false) {
+			return false
+		}
+	} else if (r.freq == "MONTHLY" || r.freq == "YEARLY") && len(r.byDay) == 0 && date.Day() != first.Day() {
+		return false
+	}
+	if len(r.byDay) > 0 && !r.matchesByDay(date) {
+		return false
+	}
+	return true
+}
+
+// matchesByDay reports whether a date matches a BYDAY entry; ordinals count within the month,
+// or within the year for a YEARLY rule without BYMONTH
+func (r *icsRule) matchesByDay(date time.Time) bool {
+	for _, weekday := range r.byDay {
+		if weekday.day != date.Weekday() {
+			continue
+		}
+		if weekday.nth == 0 {
+			return true
+		}
+		if r.freq == "YEARLY" && len(r.byMonth) == 0 {
+			daysInYear := time.Date(date.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+			if (weekday.nth > 0 && (date.YearDay()-1)/7+1 == weekday.nth) ||
+				(weekday.nth < 0 && (daysInYear-date.YearDay())/7+1 == -weekday.nth) {
+				return true
+			}
+			continue
+		}
+		if isWeekdayOccurrenceMatch(&WeekdayOccurrence{Weekday: weekday.day.String(), Nth: weekday.nth}, date) {
+			return true
+		}
+	}
+	return false
+}
+
+// weekStartOf returns the civil date starting the week of a civil date
+func weekStartOf(date time.Time, weekStart time.Weekday) time.Time {
+	return date.AddDate(0, 0, -((int(date.Weekday()) - int(weekStart) + 7) % 7))
+}
+
+// civilDate returns the calendar date of a time, as midnight UTC
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// icsInstant returns the instant of a wall time in its location, or in fallback when it has none
+func icsInstant(wall time.Time, loc, fallback *time.Location) time.Time {
+	if loc == nil {
+		loc = fallback
+	}
+	return time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, loc)
+}
+
+// exdateKey identifies an excluded occurrence start, given as a wall time in a location: the date for
+// all-day events and dates, otherwise the wall time in the event's location
+func (e icsEvent) exdateKey(wall time.Time, loc *time.Location, allDay bool) string {
+	if e.allDay || allDay {
+		return wall.Format(icsDateLayout)
+	}
+	if loc != nil && e.loc != nil {
+		wall = icsInstant(wall, loc, nil).In(e.loc)
+	}
+	return wall.Format(icsDateTimeLayout)
+}
+
+// excluded reports whether EXDATE excludes the occurrence starting at a wall time
+func (e icsEvent) excluded(wall time.Time) bool {
+	return e.exdates[wall.Format(icsDateLayout)] || (!e.allDay && e.exdates[wall.Format(icsDateTimeLayout)])
+}
+
+// occursOn reports whether the event has an occurrence starting on a civil date
+func (e icsEvent) occursOn(date time.Time) bool {
+	first := civilDate(e.start)
+	if e.rule == nil {
+		return date.Equal(first)
+	}
+	if e.rule.occurrences != nil {
+		return e.rule.occurrences[date.Format(icsDateLayout)]
+	}
+	return e.rule.matches(first, date)
+}
+
+// overlaps reports whether an occurrence of the event overlaps the calendar day of a date, in the date's location
+func (e icsEvent) overlaps(date time.Time) bool {
+	dayStart := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	dayEnd := time.Date(date.Year(), date.Month(), date.Day()+1, 0, 0, 0, 0, date.Location())
+	loc := e.loc
+	if loc == nil {
+		loc = date.Location()
+	}
+
+	// Occurrences overlapping the day start at most the event's length before it
+	last := civilDate(dayEnd.In(loc))
+	for day := civilDate(dayStart.In(loc).Add(-e.length)).AddDate(0, 0, -1); !day.After(last); day = day.AddDate(0, 0, 1) {
+		if !e.occursOn(day) {
+			continue
+		}
+		wall := time.Date(day.Year(), day.Month(), day.Day(), e.start.Hour(), e.start.Minute(), e.start.Second(), 0, time.UTC)
+		if e.excluded(wall) || e.afterUntil(wall, loc) {
+			continue
+		}
+		start := icsInstant(wall, loc, nil)
+		end := start.Add(e.length)
+		if e.allDay {
+			end = start.AddDate(0, 0, int(e.length/(24*time.Hour)))
+		}
+		if start.Before(dayEnd) && (end.After(dayStart) || (e.length == 0 && !start.Before(dayStart))) {
+			return true
+		}
+	}
+	return false
+}
+
+// afterUntil reports whether an occurrence start, a wall time in loc, is after the rule's UNTIL
+func (e icsEvent) afterUntil(wall time.Time, loc *time.Location) bool {
+	if e.rule == nil || e.rule.until == nil {
+		return false
+	}
+	if e.allDay || e.rule.untilDate {
+		return civilDate(wall).After(civilDate(*e.rule.until))
+	}
+	return icsInstant(wall, loc, nil).After(icsInstant(*e.rule.until, e.rule.untilLoc, loc))
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// testICS is a holiday calendar as exported by calendar applications, with CRLF line endings
+var testICS = strings.ReplaceAll(`BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Example Corp//HR Holidays//EN
+BEGIN:VEVENT
+UID:christmas@example.com
+DTSTART;VALUE=DATE:20251225
+DTEND;VALUE=DATE:20251226
+SUMMARY:Christmas Day
+END:VEVENT
+BEGIN:VEVENT
+UID:shutdown@example.com
+DTSTART;VALUE=DATE:20251229
+DTEND;VALUE=DATE:20260101
+SUMMARY:Year-End
+  Shutdown
+END:VEVENT
+BEGIN:VEVENT
+UID:christmas-eve@example.com
+DTSTART;TZID=Europe/Berlin:20251224T120000
+DTEND;TZID=Europe/Berlin:20251224T180000
+SUMMARY:Christmas Eve\, afternoon
+BEGIN:VALARM
+ACTION:DISPLAY
+SUMMARY:Reminder
+TRIGGER:-PT1H
+END:VALARM
+END:VEVENT
+BEGIN:VEVENT
+UID:fireworks@example.com
+DTSTART:20250704T230000Z
+DURATION:PT2H
+SUMMARY:Fireworks
+END:VEVENT
+BEGIN:VEVENT
+UID:may-day@example.com
+DTSTART:20250501T000000
+DTEND:20250502T000000
+SUMMARY:May Day
+END:VEVENT
+BEGIN:VEVENT
+UID:new-year@example.com
+DTSTART;VALUE=DATE:20200101
+RRULE:FREQ=YEARLY
+EXDATE;VALUE=DATE:20270101
+SUMMARY:New Year's Day
+END:VEVENT
+BEGIN:VEVENT
+UID:thanksgiving@example.com
+DTSTART;VALUE=DATE:20201126
+RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=4TH
+SUMMARY:Thanksgiving
+END:VEVENT
+BEGIN:VEVENT
+UID:summer-fridays@example.com
+DTSTART;TZID=America/New_York:20250606T130000
+DTEND;TZID=America/New_York:20250606T170000
+RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=FR;UNTIL=20250829T170000Z
+SUMMARY:Summer Friday
+END:VEVENT
+BEGIN:VEVENT
+UID:inventory@example.com
+DTSTART;VALUE=DATE:20250303
+RRULE:FREQ=DAILY;COUNT=3
+SUMMARY:Inventory
+END:VEVENT
+BEGIN:VEVENT
+UID:month-end@example.com
+DTSTART;VALUE=DATE:20250131
+RRULE:FREQ=MONTHLY;BYMONTHDAY=-1;UNTIL=20250630
+SUMMARY:Month-End Close
+END:VEVENT
+BEGIN:VEVENT
+UID:cancelled@example.com
+DTSTART;VALUE=DATE:20250814
+STATUS:CANCELLED
+SUMMARY:Cancelled Party
+END:VEVENT
+END:VCALENDAR
+`, "\n", "\r\n")
+
+func TestHolidayCalendarICS(t *testing.T) {
+	calendar, err := ParseHolidays(map[string]string{"holidays.ics": testICS, "2025-03-04": "Inventory Audit"})
+	if err != nil {
+		t.Fatalf("ParseHolidays() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		date     string
+		timezone string
+		wantName string
+	}{
+		{name: "All-day event", date: "2025-12-25", timezone: "UTC", wantName: "Christmas Day"},
+		{name: "Day after an all-day event", date: "2025-12-26", timezone: "UTC"},
+		{name: "All-day events follow the schedule's timezone", date: "2025-12-25", timezone: "Pacific/Auckland", wantName: "Christmas Day"},
+		{name: "Multi-day event with a folded summary", date: "2025-12-31", timezone: "UTC", wantName: "Year-End Shutdown"},
+		{name: "DTEND of an all-day event is exclusive", date: "2026-01-01", timezone: "UTC", wantName: "New Year's Day"},
+		{name: "ConfigMap keys apply before the calendar", date: "2025-03-04", timezone: "UTC", wantName: "Inventory Audit"},
+		{name: "Timed event", date: "2025-12-24", timezone: "Europe/Berlin", wantName: "Christmas Eve, afternoon"},
+		{name: "Timed event in another timezone", date: "2025-12-24", timezone: "America/New_York", wantName: "Christmas Eve, afternoon"},
+		{name: "Day before a timed event", date: "2025-12-23", timezone: "Europe/Berlin"},
+		{name: "Timed event crossing midnight covers both days", date: "2025-07-05", timezone: "UTC", wantName: "Fireworks"},
+		{name: "Timed event moved by the schedule's timezone", date: "2025-07-05", timezone: "America/New_York"},
+		{name: "Floating event uses the schedule's timezone", date: "2025-05-01", timezone: "Asia/Tokyo", wantName: "May Day"},
+		{name: "Floating event ends at midnight", date: "2025-05-02", timezone: "Asia/Tokyo"},
+		{name: "Yearly rule", date: "2031-01-01", timezone: "UTC", wantName: "New Year's Day"},
+		{name: "Yearly rule before DTSTART", date: "2019-01-01", timezone: "UTC"},
+		{name: "EXDATE removes an occurrence", date: "2027-01-01", timezone: "UTC"},
+		{name: "Yearly rule on the fourth Thursday", date: "2027-11-25", timezone: "UTC", wantName: "Thanksgiving"},
+		{name: "Yearly rule on a Thursday that is not the fourth", date: "2027-11-18", timezone: "UTC"},
+		{name: "Biweekly rule", date: "2025-06-20", timezone: "America/New_York", wantName: "Summer Friday"},
+		{name: "Biweekly rule in the week between", date: "2025-06-13", timezone: "America/New_York"},
+		{name: "Weekly rule on its UNTIL", date: "2025-08-29", timezone: "America/New_York", wantName: "Summer Friday"},
+		{name: "Weekly rule after its UNTIL", date: "2025-09-12", timezone: "America/New_York"},
+		{name: "Last occurrence of a COUNT", date: "2025-03-05", timezone: "UTC", wantName: "Inventory"},
+		{name: "After the COUNT", date: "2025-03-06", timezone: "UTC"},
+		{name: "Monthly rule on the last day", date: "2025-04-30", timezone: "UTC", wantName: "Month-End Close"},
+		{name: "Monthly rule after its UNTIL", date: "2025-07-31", timezone: "UTC"},
+		{name: "Cancelled event", date: "2025-08-14", timezone: "UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.timezone)
+			if err != nil {
+				t.Fatal(err)
+			}
+			day, err := time.ParseInLocation(DateLayout, tt.date, loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			name, ok := calendar.Holiday(day.Add(12 * time.Hour))
+			if ok != (tt.wantName != "") || name != tt.wantName {
+				t.Errorf("Holiday(%s in %s) = %q, %v, want %q", tt.date, tt.timezone, name, ok, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestHolidayCalendarInvalidICS(t *testing.T) {
+	valid := "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251225\nSUMMARY:Christmas Day\nEND:VEVENT\n"
+
+	tests := []struct {
+		name  string
+		event string
+	}{
+		{name: "Missing DTSTART", event: "BEGIN:VEVENT\nSUMMARY:Nothing\nEND:VEVENT\n"},
+		{name: "Invalid date", event: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251332\nEND:VEVENT\n"},
+		{name: "Unknown TZID", event: "BEGIN:VEVENT\nDTSTART;TZID=Mars/Olympus:20251225T090000\nEND:VEVENT\n"},
+		{name: "DTEND before DTSTART", event: "BEGIN:VEVENT\nDTSTART:20251225T090000\nDTEND:20251225T080000\nEND:VEVENT\n"},
+		{name: "Date and date-time mixed", event: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251225\nDTEND:20251226T000000\nEND:VEVENT\n"},
+		{name: "Invalid duration", event: "BEGIN:VEVENT\nDTSTART:20251225T090000\nDURATION:PT\nEND:VEVENT\n"},
+		{name: "Unsupported frequency", event: "BEGIN:VEVENT\nDTSTART:20251225T090000\nRRULE:FREQ=HOURLY\nEND:VEVENT\n"},
+		{name: "Unsupported rule part", event: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251225\nRRULE:FREQ=MONTHLY;BYDAY=MO;BYSETPOS=1\nEND:VEVENT\n"},
+		{name: "Ordinal in a weekly rule", event: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251225\nRRULE:FREQ=WEEKLY;BYDAY=2MO\nEND:VEVENT\n"},
+		{name: "COUNT and UNTIL", event: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251225\nRRULE:FREQ=DAILY;COUNT=2;UNTIL=20260101\nEND:VEVENT\n"},
+		{name: "Unterminated event", event: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251225\n"},
+		{name: "Line without a value", event: "BEGIN:VEVENT\nDTSTART\nEND:VEVENT\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calendar, err := ParseHolidays(map[string]string{"holidays.ics": valid + tt.event})
+			if err == nil {
+				t.Fatalf("ParseHolidays() expected an error")
+			}
+			// The valid events still apply
+			if name, _ := calendar.Holiday(time.Date(2025, time.December, 25, 12, 0, 0, 0, time.UTC)); name != "Christmas Day" {
+				t.Errorf("Holiday() = %q for a valid event next to an invalid one, want Christmas Day", name)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"time"
+)
+
+// leadTime returns the lead time of a window, falling back to the lead time of the input
+func leadTime(input Input, ws WindowSpec) time.Duration {
+	if ws.LeadTime != nil {
+		return *ws.LeadTime
+	}
+	return input.LeadTime
+}
+
+// preWarmWindow returns the window whose scale-up is pulled forward by its lead time at now, or nil.
+// A window pre-warms during the lead time before one of its occurrences starts when it is the window
+// chosen at that start and its replicas exceed those scheduled now, so scale-downs are never pulled forward.
+// When several windows pre-warm, the one with the most replicas applies.
+func preWarmWindow(input Input, nowLocal time.Time, loc *time.Location, scheduled int32) *Window {
+	var best *Window
+	for i, ws := range input.Windows {
+		lead := leadTime(input, ws)
+		if lead <= 0 {
+			continue
+		}
+		window, active, err := evaluateWindow(ws, nowLocal, loc, input.DSTPolicy)
+		if err != nil || active || window.Start.After(nowLocal.Add(lead)) {
+			continue
+		}
+
+		chosen := resolveConflict(windowsAt(input, window.Start, loc), input.ConflictResolution)
+		if chosen == nil || chosen.index != i || chosen.Replicas <= scheduled {
+			continue
+		}
+		if best == nil || chosen.Replicas > best.Replicas {
+			best = chosen
+		}
+	}
+	return best
+}
+
+// nextPreWarm returns the earliest time after now at which a window's lead time begins, or zero without one
+func nextPreWarm(input Input, nowLocal time.Time, loc *time.Location) time.Time {
+	var next time.Time
+	for _, ws := range input.Windows {
+		lead := leadTime(input, ws)
+		if lead <= 0 {
+			continue
+		}
+		window, active, err := evaluateWindow(ws, nowLocal, loc, input.DSTPolicy)
+		if err != nil || active {
+			continue
+		}
+		if start := window.Start.Add(-lead); start.After(nowLocal) && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+	}
+	return next
+}
+
+// scheduledAt returns the window whose replicas apply at a time, including pre-warming windows,
+// and those replicas; without a window it returns nil and the default replicas
+func scheduledAt(input Input, t time.Time, loc *time.Location) (*Window, int32) {
+	chosen := resolveConflict(windowsAt(input, t, loc), input.ConflictResolution)
+	replicas := scheduledReplicas(chosen, input.DefaultReplicas)
+	if window := preWarmWindow(input, t.In(loc), loc, replicas); window != nil {
+		return window, window.Replicas
+	}
+	return chosen, replicas
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLeadTime(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, 3, 10, hour, minute, 0, 0, time.UTC) // Monday
+	}
+	duration := func(d time.Duration) *time.Duration {
+		return &d
+	}
+	business := WindowSpec{Start: "09:00", End: "17:00", Replicas: 40, Name: "business-hours", LeadTime: duration(4 * time.Minute)}
+
+	tests := []struct {
+		name             string
+		now              time.Time
+		windows          []WindowSpec
+		leadTime         time.Duration
+		wantReplicas     int32
+		wantWindow       string
+		wantReason       string
+		wantNextBoundary time.Time
+	}{
+		{
+			name:             "Before the lead time",
+			now:              at(8, 50),
+			windows:          []WindowSpec{business},
+			wantReplicas:     2,
+			wantWindow:       "Default",
+			wantReason:       "no-matching-window",
+			wantNextBoundary: at(8, 56),
+		},
+		{
+			name:             "Scale-up starts at the lead time",
+			now:              at(8, 56),
+			windows:          []WindowSpec{business},
+			wantReplicas:     40,
+			wantWindow:       "Default",
+			wantReason:       "pre-warming",
+			wantNextBoundary: at(9, 0),
+		},
+		{
+			name:             "Window starts unchanged",
+			now:              at(9, 0),
+			windows:          []WindowSpec{business},
+			wantReplicas:     40,
+			wantWindow:       "business-hours",
+			wantReason:       "in-window",
+			wantNextBoundary: at(17, 0),
+		},
+		{
+			name: "Scale-down is not pulled forward",
+			now:  at(11, 55),
+			windows: []WindowSpec{
+				{Start: "09:00", End: "12:00", Replicas: 40, Name: "peak"},
+				{Start: "12:00", End: "13:00", Replicas: 10, Name: "lunch", LeadTime: duration(10 * time.Minute)},
+			},
+			wantReplicas:     40,
+			wantWindow:       "peak",
+			wantReason:       "in-window",
+			wantNextBoundary: at(12, 0),
+		},
+		{
+			name:             "Global lead time",
+			now:              at(8, 57),
+			windows:          []WindowSpec{{Start: "09:00", End: "17:00", Replicas: 40, Name: "business-hours"}},
+			leadTime:         4 * time.Minute,
+			wantReplicas:     40,
+			wantWindow:       "Default",
+			wantReason:       "pre-warming",
+			wantNextBoundary: at(9, 0),
+		},
+		{
+			name:             "Window lead time overrides the global one",
+			now:              at(8, 57),
+			windows:          []WindowSpec{{Start: "09:00", End: "17:00", Replicas: 40, Name: "business-hours", LeadTime: duration(0)}},
+			leadTime:         4 * time.Minute,
+			wantReplicas:     2,
+			wantWindow:       "Default",
+			wantReason:       "no-matching-window",
+			wantNextBoundary: at(9, 0),
+		},
+		{
+			name: "No pre-warming when another window applies at the start",
+			now:  at(8, 57),
+			windows: []WindowSpec{
+				business,
+				{Start: "08:00", End: "10:00", Replicas: 60, Name: "warm-up"},
+			},
+			wantReplicas:     60,
+			wantWindow:       "warm-up",
+			wantReason:       "in-window",
+			wantNextBoundary: at(9, 0),
+		},
+		{
+			name: "Ramp-up starts at the lead time",
+			now:  at(8, 55),
+			windows: []WindowSpec{
+				{Start: "09:00", End: "17:00", Replicas: 12, Name: "business-hours", LeadTime: duration(10 * time.Minute), RampDuration: 10 * time.Minute},
+			},
+			wantReplicas:     7,
+			wantWindow:       "Default",
+			wantReason:       "ramping",
+			wantNextBoundary: at(8, 56),
+		},
+		{
+			name: "Ramp-up finishes when the window starts",
+			now:  at(9, 0),
+			windows: []WindowSpec{
+				{Start: "09:00", End: "17:00", Replicas: 12, Name: "business-hours", LeadTime: duration(10 * time.Minute), RampDuration: 10 * time.Minute},
+			},
+			wantReplicas:     12,
+			wantWindow:       "business-hours",
+			wantReason:       "in-window",
+			wantNextBoundary: at(17, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ComputeEffectiveReplicas(Input{
+				Now:             tt.now,
+				Timezone:        "UTC",
+				Windows:         tt.windows,
+				DefaultReplicas: 2,
+				LeadTime:        tt.leadTime,
+			})
+			if err != nil {
+				t.Fatalf("ComputeEffectiveReplicas() error = %v", err)
+			}
+
+			if output.EffectiveReplicas != tt.wantReplicas {
+				t.Errorf("EffectiveReplicas = %v, want %v", output.EffectiveReplicas, tt.wantReplicas)
+			}
+			if output.CurrentWindow != tt.wantWindow {
+				t.Errorf("CurrentWindow = %v, want %v", output.CurrentWindow, tt.wantWindow)
+			}
+			if output.Reason != tt.wantReason {
+				t.Errorf("Reason = %v, want %v", output.Reason, tt.wantReason)
+			}
+			if !output.NextBoundary.Equal(tt.wantNextBoundary) {
+				t.Errorf("NextBoundary = %v, want %v", output.NextBoundary, tt.wantNextBoundary)
+			}
+		})
+	}
+}
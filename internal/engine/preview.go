@@ -0,0 +1,103 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"time"
+)
+
+// maxPreviewSteps bounds the boundaries evaluated by Preview
+const maxPreviewSteps = 10000
+
+// Transition is a future change of the scheduled replicas
+type Transition struct {
+	Time                 time.Time
+	Window               string
+	EffectiveReplicas    int32
+	EffectiveMaxReplicas *int32
+	Reason               string
+}
+
+// Preview returns the transitions of the schedule after input.Now up to the horizon, in order.
+// It shows the plan rather than the live state, so grace periods and pause are not applied.
+// isHoliday reports whether a date in the schedule's timezone is a holiday; when nil,
+// input.IsHoliday applies to the current day only.
+func Preview(input Input, horizon time.Duration, isHoliday func(date time.Time) bool) ([]Transition, error) {
+	loc, err := time.LoadLocation(input.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %s: %w", input.Timezone, err)
+	}
+	today := input.Now.In(loc).Format(DateLayout)
+
+	at := func(t time.Time) (Output, error) {
+		planned := input
+		planned.Now = t
+		planned.CurrentReplicas = 0
+		planned.LastScaleTime = nil
+		planned.Pause = false
+		if isHoliday != nil {
+			planned.IsHoliday = isHoliday(t.In(loc))
+		} else {
+			planned.IsHoliday = input.IsHoliday && t.In(loc).Format(DateLayout) == today
+		}
+		return ComputeEffectiveReplicas(planned)
+	}
+
+	previous, err := at(input.Now)
+	if err != nil {
+		return nil, err
+	}
+	until := input.Now.Add(horizon)
+	now := input.Now
+
+	var transitions []Transition
+	for step := 0; step < maxPreviewSteps; step++ {
+		next := previous.NextBoundary
+		if !next.After(now) || next.After(until) {
+			break
+		}
+		output, err := at(next)
+		if err != nil {
+			return nil, err
+		}
+		if isTransition(previous, output) {
+			transitions = append(transitions, Transition{
+				Time:                 next,
+				Window:               output.CurrentWindow,
+				EffectiveReplicas:    output.EffectiveReplicas,
+				EffectiveMaxReplicas: output.EffectiveMaxReplicas,
+				Reason:               output.Reason,
+			})
+		}
+		previous, now = output, next
+	}
+	return transitions, nil
+}
+
+// isTransition reports whether the scheduled replicas, bounds, window or reason changed between two outputs
+func isTransition(previous, next Output) bool {
+	if previous.EffectiveReplicas != next.EffectiveReplicas ||
+		previous.CurrentWindow != next.CurrentWindow ||
+		previous.Reason != next.Reason {
+		return true
+	}
+	if (previous.EffectiveMaxReplicas == nil) != (next.EffectiveMaxReplicas == nil) {
+		return true
+	}
+	return previous.EffectiveMaxReplicas != nil && *previous.EffectiveMaxReplicas != *next.EffectiveMaxReplicas
+}
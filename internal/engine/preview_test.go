@@ -0,0 +1,129 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPreview(t *testing.T) {
+	friday := time.Date(2025, 3, 14, 10, 0, 0, 0, time.UTC)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 3, day, hour, minute, 0, 0, time.UTC)
+	}
+	businessHours := WindowSpec{Start: "09:00", End: "17:00", Replicas: 5, Name: "business-hours",
+		Days: []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}}
+	lastScale := friday.Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		input     Input
+		horizon   time.Duration
+		isHoliday func(date time.Time) bool
+		want      []Transition
+	}{
+		{
+			name: "Business hours over a weekend",
+			input: Input{Now: friday, Timezone: "UTC", Windows: []WindowSpec{businessHours},
+				DefaultReplicas: 1},
+			horizon: 4 * 24 * time.Hour,
+			want: []Transition{
+				{Time: at(14, 17, 0), Window: "Default", EffectiveReplicas: 1, Reason: "no-matching-window"},
+				{Time: at(17, 9, 0), Window: "business-hours", EffectiveReplicas: 5, Reason: "in-window"},
+				{Time: at(17, 17, 0), Window: "Default", EffectiveReplicas: 1, Reason: "no-matching-window"},
+				{Time: at(18, 9, 0), Window: "business-hours", EffectiveReplicas: 5, Reason: "in-window"},
+			},
+		},
+		{
+			name: "Horizon ends before the next boundary",
+			input: Input{Now: friday, Timezone: "UTC", Windows: []WindowSpec{businessHours},
+				DefaultReplicas: 1},
+			horizon: time.Hour,
+		},
+		{
+			name: "Grace periods are not applied",
+			input: Input{Now: friday, Timezone: "UTC", Windows: []WindowSpec{businessHours},
+				DefaultReplicas: 1, CurrentReplicas: 5, LastScaleTime: &lastScale, GracePeriodSecs: 3600},
+			horizon: 8 * time.Hour,
+			want: []Transition{
+				{Time: at(14, 17, 0), Window: "Default", EffectiveReplicas: 1, Reason: "no-matching-window"},
+			},
+		},
+		{
+			name: "Holiday closes Monday",
+			input: Input{Now: friday, Timezone: "UTC", Windows: []WindowSpec{businessHours},
+				DefaultReplicas: 1, HolidayMode: "treat-as-closed"},
+			horizon: 4 * 24 * time.Hour,
+			isHoliday: func(date time.Time) bool {
+				return date.Format(DateLayout) == "2025-03-17"
+			},
+			want: []Transition{
+				{Time: at(14, 17, 0), Window: "Default", EffectiveReplicas: 1, Reason: "no-matching-window"},
+				{Time: at(17, 0, 0), Window: "Holiday-Closed", EffectiveReplicas: 0, Reason: "holiday-closed"},
+				{Time: at(18, 0, 0), Window: "Default", EffectiveReplicas: 1, Reason: "no-matching-window"},
+				{Time: at(18, 9, 0), Window: "business-hours", EffectiveReplicas: 5, Reason: "in-window"},
+			},
+		},
+		{
+			name: "Today's holiday ends at midnight without a calendar",
+			input: Input{Now: friday, Timezone: "UTC", Windows: []WindowSpec{businessHours},
+				DefaultReplicas: 1, HolidayMode: "treat-as-closed", IsHoliday: true},
+			horizon: 24 * time.Hour,
+			want: []Transition{
+				{Time: at(15, 0, 0), Window: "Default", EffectiveReplicas: 1, Reason: "no-matching-window"},
+			},
+		},
+		{
+			name: "Ramp steps are transitions",
+			input: Input{Now: friday, Timezone: "UTC", DefaultReplicas: 1, Windows: []WindowSpec{
+				{Start: "12:00", End: "17:00", Replicas: 7, Name: "peak", RampDuration: 30 * time.Minute, StepSize: 3},
+			}},
+			horizon: 3 * time.Hour,
+			want: []Transition{
+				{Time: at(14, 12, 0), Window: "peak", EffectiveReplicas: 1, Reason: "ramping"},
+				{Time: at(14, 12, 15), Window: "peak", EffectiveReplicas: 4, Reason: "ramping"},
+				{Time: at(14, 12, 30), Window: "peak", EffectiveReplicas: 7, Reason: "in-window"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Preview(tt.input, tt.horizon, tt.isHoliday)
+			if err != nil {
+				t.Fatalf("Preview() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Preview() returned %d transitions, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i, want := range tt.want {
+				if !got[i].Time.Equal(want.Time) || got[i].Window != want.Window ||
+					got[i].EffectiveReplicas != want.EffectiveReplicas || got[i].Reason != want.Reason {
+					t.Errorf("transition %d = %+v, want %+v", i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestPreviewInvalidTimezone(t *testing.T) {
+	_, err := Preview(Input{Now: time.Now(), Timezone: "Mars/Olympus_Mons"}, time.Hour, nil)
+	if err == nil {
+		t.Error("Preview() expected error for invalid timezone")
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"time"
+)
+
+// ramp is the state of a gradual transition across a window boundary
+type ramp struct {
+	replicas int32     // Replicas for the current step
+	nextStep time.Time // When the next step is due, or the end of the ramp
+}
+
+// currentRamp returns the ramp in progress at now, or nil when none applies.
+// A window with a RampDuration moves the replicas from those scheduled just before one of its
+// boundaries to those scheduled now, in steps of StepSize spread evenly over the ramp duration.
+// A window pre-warming for its lead time ramps up from the start of the lead time.
+// When ramps of several windows overlap, the one following the latest boundary applies.
+func currentRamp(input Input, nowLocal time.Time, loc *time.Location, current *Window, target int32) *ramp {
+	var latest *ramp
+	var latestBoundary time.Time
+	for i, ws := range input.Windows {
+		if ws.RampDuration <= 0 {
+			continue
+		}
+		for _, b := range recentBoundaries(input, ws, nowLocal, loc) {
+			if !b.at.After(latestBoundary) {
+				continue
+			}
+
+			// The ramp only applies when this window is the one scheduled on its side of the boundary
+			before, from := scheduledAt(input, b.at.Add(-time.Nanosecond), loc)
+			chosen := current
+			if !b.entering {
+				chosen = before
+			}
+			if chosen == nil || chosen.index != i || from == target {
+				continue
+			}
+			latest = rampStep(ws, b.at, nowLocal, from, target)
+			latestBoundary = b.at
+		}
+	}
+	return latest
+}
+
+// boundary is a point in time where the replicas scheduled by a window may change
+type boundary struct {
+	at       time.Time
+	entering bool // Whether the window applies after the boundary rather than before it
+}
+
+// recentBoundaries returns the starts, ends and lead time starts of a window's occurrences
+// that passed within the last RampDuration
+func recentBoundaries(input Input, ws WindowSpec, nowLocal time.Time, loc *time.Location) []boundary {
+	since := nowLocal.Add(-ws.RampDuration)
+	recent := func(t time.Time) bool {
+		return t.After(since) && !t.After(nowLocal)
+	}
+
+	var boundaries []boundary
+	if window, _, err := evaluateWindow(ws, since, loc, input.DSTPolicy); err == nil {
+		if recent(window.Start) {
+			boundaries = append(boundaries, boundary{at: window.Start, entering: true})
+		}
+		if recent(window.End) {
+			boundaries = append(boundaries, boundary{at: window.End})
+		}
+	}
+	if lead := leadTime(input, ws); lead > 0 {
+		if window, _, err := evaluateWindow(ws, since.Add(lead), loc, input.DSTPolicy); err == nil && recent(window.Start.Add(-lead)) {
+			boundaries = append(boundaries, boundary{at: window.Start.Add(-lead), entering: true})
+		}
+	}
+	return boundaries
+}
+
+// rampStep computes the replicas and next step time of a ramp from one replica count to another
+func rampStep(ws WindowSpec, boundary, nowLocal time.Time, from, to int32) *ramp {
+	stepSize := ws.StepSize
+	if stepSize <= 0 {
+		stepSize = 1 // Linear
+	}
+	diff := to - from
+	direction := int32(1)
+	if diff < 0 {
+		diff, direction = -diff, -1
+	}
+	steps := int64((diff + stepSize - 1) / stepSize)
+
+	// Step k is reached at boundary + k*rampDuration/steps; the last step is the target itself
+	elapsed := int64(nowLocal.Sub(boundary))
+	duration := int64(ws.RampDuration)
+	done := elapsed * steps / duration
+	change := min(int32(done)*stepSize, diff)
+	nextStep := boundary.Add(time.Duration(((done+1)*duration + steps - 1) / steps))
+
+	return &ramp{
+		replicas: from + direction*change,
+		nextStep: nextStep,
+	}
+}
+
+// windowsAt returns the windows active at a time, in spec order
+func windowsAt(input Input, t time.Time, loc *time.Location) []*Window {
+	matched, _ := matchWindows(input, t.In(loc), loc)
+	return matched
+}
+
+// scheduledReplicas returns the replicas of the chosen window, or the default replicas without one
+func scheduledReplicas(chosen *Window, defaultReplicas int32) int32 {
+	if chosen == nil {
+		return defaultReplicas
+	}
+	return chosen.Replicas
+}
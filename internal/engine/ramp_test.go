@@ -0,0 +1,190 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRamps(t *testing.T) {
+	at := func(hour, minute, second int) time.Time {
+		return time.Date(2025, 3, 10, hour, minute, second, 0, time.UTC) // Monday
+	}
+	linear := WindowSpec{Start: "09:00", End: "17:00", Replicas: 40, Name: "business-hours", RampDuration: 38 * time.Minute}
+	stepped := WindowSpec{Start: "09:00", End: "17:00", Replicas: 40, Name: "business-hours", RampDuration: 20 * time.Minute, StepSize: 10}
+	lastScale := at(17, 9, 0)
+
+	tests := []struct {
+		name             string
+		now              time.Time
+		windows          []WindowSpec
+		currentReplicas  int32
+		lastScaleTime    *time.Time
+		wantReplicas     int32
+		wantWindow       string
+		wantReason       string
+		wantNextBoundary time.Time
+	}{
+		{
+			name:             "Linear ramp-up starts at the boundary",
+			now:              at(9, 0, 0),
+			windows:          []WindowSpec{linear},
+			wantReplicas:     2,
+			wantWindow:       "business-hours",
+			wantReason:       "ramping",
+			wantNextBoundary: at(9, 1, 0),
+		},
+		{
+			name:             "Linear ramp-up midway",
+			now:              at(9, 10, 30),
+			windows:          []WindowSpec{linear},
+			wantReplicas:     12,
+			wantWindow:       "business-hours",
+			wantReason:       "ramping",
+			wantNextBoundary: at(9, 11, 0),
+		},
+		{
+			name:             "Linear ramp-up complete",
+			now:              at(9, 38, 0),
+			windows:          []WindowSpec{linear},
+			wantReplicas:     40,
+			wantWindow:       "business-hours",
+			wantReason:       "in-window",
+			wantNextBoundary: at(17, 0, 0),
+		},
+		{
+			name:             "Stepped ramp-up first step",
+			now:              at(9, 7, 0),
+			windows:          []WindowSpec{stepped},
+			wantReplicas:     12,
+			wantWindow:       "business-hours",
+			wantReason:       "ramping",
+			wantNextBoundary: at(9, 10, 0),
+		},
+		{
+			name:             "Stepped ramp-up last partial step",
+			now:              at(9, 19, 59),
+			windows:          []WindowSpec{stepped},
+			wantReplicas:     32,
+			wantWindow:       "business-hours",
+			wantReason:       "ramping",
+			wantNextBoundary: at(9, 20, 0),
+		},
+		{
+			name:             "Linear ramp-down after the window ends",
+			now:              at(17, 10, 0),
+			windows:          []WindowSpec{linear},
+			wantReplicas:     30,
+			wantWindow:       "Default",
+			wantReason:       "ramping",
+			wantNextBoundary: at(17, 11, 0),
+		},
+		{
+			name:             "Ramp-down replaces the grace period",
+			now:              at(17, 10, 0),
+			windows:          []WindowSpec{linear},
+			currentReplicas:  31,
+			lastScaleTime:    &lastScale,
+			wantReplicas:     30,
+			wantWindow:       "Default",
+			wantReason:       "ramping",
+			wantNextBoundary: at(17, 11, 0),
+		},
+		{
+			name: "Ramp between windows starts from the previous window",
+			now:  at(12, 6, 0),
+			windows: []WindowSpec{
+				{Start: "09:00", End: "12:00", Replicas: 10, Name: "morning"},
+				{Start: "12:00", End: "17:00", Replicas: 30, Name: "afternoon", RampDuration: 20 * time.Minute, StepSize: 5},
+			},
+			wantReplicas:     15,
+			wantWindow:       "afternoon",
+			wantReason:       "ramping",
+			wantNextBoundary: at(12, 10, 0),
+		},
+		{
+			name: "No ramp while another window is chosen",
+			now:  at(9, 5, 0),
+			windows: []WindowSpec{
+				linear,
+				{Start: "08:00", End: "10:00", Replicas: 60, Name: "warm-up"},
+			},
+			wantReplicas:     60,
+			wantWindow:       "warm-up",
+			wantReason:       "in-window",
+			wantNextBoundary: at(10, 0, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ComputeEffectiveReplicas(Input{
+				Now:             tt.now,
+				Timezone:        "UTC",
+				Windows:         tt.windows,
+				DefaultReplicas: 2,
+				CurrentReplicas: tt.currentReplicas,
+				LastScaleTime:   tt.lastScaleTime,
+				GracePeriodSecs: 300,
+			})
+			if err != nil {
+				t.Fatalf("ComputeEffectiveReplicas() error = %v", err)
+			}
+
+			if output.EffectiveReplicas != tt.wantReplicas {
+				t.Errorf("EffectiveReplicas = %v, want %v", output.EffectiveReplicas, tt.wantReplicas)
+			}
+			if output.CurrentWindow != tt.wantWindow {
+				t.Errorf("CurrentWindow = %v, want %v", output.CurrentWindow, tt.wantWindow)
+			}
+			if output.Reason != tt.wantReason {
+				t.Errorf("Reason = %v, want %v", output.Reason, tt.wantReason)
+			}
+			if !output.NextBoundary.Equal(tt.wantNextBoundary) {
+				t.Errorf("NextBoundary = %v, want %v", output.NextBoundary, tt.wantNextBoundary)
+			}
+		})
+	}
+}
+
+func TestRampStep(t *testing.T) {
+	boundary := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	ws := WindowSpec{RampDuration: 10 * time.Minute, StepSize: 3}
+
+	// 10 to 0 in steps of 3 takes four steps: 7, 4, 1 and 0, every 2m30s
+	tests := []struct {
+		elapsed      time.Duration
+		wantReplicas int32
+		wantNextStep time.Duration
+	}{
+		{elapsed: 0, wantReplicas: 10, wantNextStep: 150 * time.Second},
+		{elapsed: 150 * time.Second, wantReplicas: 7, wantNextStep: 300 * time.Second},
+		{elapsed: 449 * time.Second, wantReplicas: 4, wantNextStep: 450 * time.Second},
+		{elapsed: 450 * time.Second, wantReplicas: 1, wantNextStep: 600 * time.Second},
+	}
+
+	for _, tt := range tests {
+		r := rampStep(ws, boundary, boundary.Add(tt.elapsed), 10, 0)
+		if r.replicas != tt.wantReplicas {
+			t.Errorf("after %v: replicas = %v, want %v", tt.elapsed, r.replicas, tt.wantReplicas)
+		}
+		if !r.nextStep.Equal(boundary.Add(tt.wantNextStep)) {
+			t.Errorf("after %v: nextStep = %v, want %v", tt.elapsed, r.nextStep, boundary.Add(tt.wantNextStep))
+		}
+	}
+}
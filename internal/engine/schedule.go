@@ -23,44 +23,109 @@ import (
 	"time"
 )
 
+// DateLayout is the YYYY-MM-DD layout of window start and end dates
+const DateLayout = "2006-01-02"
+
+// DateTimeLayout is the YYYY-MM-DDTHH:MM layout of one-off window start and end times
+const DateTimeLayout = "2006-01-02T15:04"
+
+// maxDateSearch bounds the days scanned for the next occurrence of a window
+const maxDateSearch = 5 * 366
+
 // Window represents a parsed time window
 type Window struct {
-	Start    time.Time // Start time today or tomorrow
-	End      time.Time // End time today or tomorrow
-	Replicas int32
-	Name     string
-	Days     []string // Optional day restriction
+	Start       time.Time // Start time of the current or next occurrence
+	End         time.Time // End time of the current or next occurrence
+	Replicas    int32
+	MaxReplicas *int32 // Optional upper bound for HorizontalPodAutoscaler targets
+	Name        string
+	Days        []string // Optional day restriction
+	Priority    int32
+	index       int  // Position of the window specification in Input.Windows
+	multiDay    bool // Whether the window has a StartDay and EndDay
 }
 
 // Input contains the input for computing effective replicas
 type Input struct {
-	Now             time.Time
-	Timezone        string
-	Windows         []WindowSpec
-	DefaultReplicas int32
-	HolidayMode     string
-	IsHoliday       bool
-	Pause           bool
-	GracePeriodSecs int32
-	LastScaleTime   *time.Time
-	CurrentReplicas int32
+	Now                time.Time
+	Timezone           string
+	Windows            []WindowSpec
+	DefaultReplicas    int32
+	DefaultMaxReplicas *int32 // Optional: upper bound when no window matches (HPA targets)
+	HolidayMode        string
+	IsHoliday          bool
+	Pause              bool
+	GracePeriodSecs    int32
+	LastScaleTime      *time.Time
+	CurrentReplicas    int32
+	Override           *Override // Optional: manual override taking precedence until it expires
+	// ConflictResolution picks among overlapping windows:
+	// LastWins (default), HighestPriority, MaxReplicas or MinReplicas
+	ConflictResolution string
+	// LeadTime starts the scale-up of a window this much before it starts; scale-downs are never pulled forward
+	LeadTime time.Duration
+	// DSTPolicy decides what happens to window starts skipped by a DST gap: ShiftForward (default) or Skip
+	DSTPolicy string
+}
+
+// Override pins the replica count until a point in time
+type Override struct {
+	Replicas int32
+	Until    time.Time
 }
 
 // WindowSpec is a window specification from the API
 type WindowSpec struct {
-	Start    string // HH:MM format
-	End      string // HH:MM format
-	Replicas int32
-	Name     string
-	Days     []string // Optional: ["Monday", "Tuesday"]
+	Start       string // HH:MM format
+	End         string // HH:MM format
+	Replicas    int32
+	MaxReplicas *int32 // Optional: upper bound for HPA targets
+	Name        string
+	Priority    int32    // Optional: preferred by the HighestPriority conflict resolution
+	Days        []string // Optional: ["Monday", "Tuesday"]
+	// StartDay and EndDay describe a window spanning several days, e.g. Friday 18:00 to Monday 06:00,
+	// used instead of Days when set
+	StartDay    string   // Optional: full day name the window starts on
+	EndDay      string   // Full day name the window ends on
+	Months      []string // Optional: ["November", "December"]
+	StartDate   string   // Optional: first date (YYYY-MM-DD, inclusive) an occurrence may start on
+	EndDate     string   // Optional: last date (YYYY-MM-DD, inclusive) an occurrence may start on
+	DaysOfMonth []int    // Optional: [1, 15, -1]; negative days count back from the end of the month
+	// BusinessDaysOfMonth optionally restricts the window to business days (Monday to Friday) of the month,
+	// counted like DaysOfMonth, so -1 is the last business day
+	BusinessDaysOfMonth []int
+	// WeekdayOccurrence optionally restricts the window to the nth weekday of the month
+	WeekdayOccurrence *WeekdayOccurrence
+	// StartCron and Duration describe a cron window, used instead of Start/End/Days when set
+	StartCron string        // Optional: five-field cron expression for window starts
+	Duration  time.Duration // Length of each cron occurrence
+	// StartAt and EndAt describe a one-off window that never recurs, used instead of Start/End when set
+	StartAt string // Optional: YYYY-MM-DDTHH:MM in the schedule's timezone
+	EndAt   string // YYYY-MM-DDTHH:MM in the schedule's timezone
+	// RampDuration and StepSize spread the change of replicas at the window's boundaries over time
+	RampDuration time.Duration // Optional: length of the ramp after each boundary
+	StepSize     int32         // Optional: replicas added or removed per step; 1 (linear) when unset
+	// LeadTime optionally overrides Input.LeadTime for this window; zero disables it
+	LeadTime *time.Duration
+}
+
+// WeekdayOccurrence selects the nth occurrence of a weekday in a month, e.g. the last Friday
+type WeekdayOccurrence struct {
+	Weekday string // Full day name, e.g. "Friday"
+	Nth     int    // 1 to 5 counts from the start of the month, -1 to -5 from the end
 }
 
 // Output contains the computed values
 type Output struct {
+	// EffectiveReplicas is the replica count, or the lower bound for HPA targets
 	EffectiveReplicas int32
-	NextBoundary      time.Time
-	CurrentWindow     string
-	Reason            string
+	// EffectiveMaxReplicas is the upper bound for HPA targets; nil leaves it unchanged
+	EffectiveMaxReplicas *int32
+	NextBoundary         time.Time
+	CurrentWindow        string
+	Reason               string
+	// MatchedWindows lists the active windows in spec order; CurrentWindow is the one chosen
+	MatchedWindows []string
 }
 
 // ComputeEffectiveReplicas calculates the desired replica count based on time windows
@@ -74,6 +139,20 @@ func ComputeEffectiveReplicas(input Input) (Output, error) {
 	// Get current time in the specified timezone
 	nowLocal := input.Now.In(loc)
 
+	// Handle an active manual override - it takes precedence over holidays, windows and grace periods
+	if input.Override != nil && nowLocal.Before(input.Override.Until) {
+		out := Output{
+			EffectiveReplicas: input.Override.Replicas,
+			NextBoundary:      input.Override.Until.In(loc),
+			CurrentWindow:     "Override",
+			Reason:            "override",
+		}
+		if input.Pause {
+			out.Reason = "paused"
+		}
+		return out, nil
+	}
+
 	// Handle pause mode - compute but don't apply
 	if input.Pause {
 		out := computeWithoutPause(input, nowLocal, loc)
@@ -86,24 +165,36 @@ func ComputeEffectiveReplicas(input Input) (Output, error) {
 		switch input.HolidayMode {
 		case "treat-as-closed":
 			return Output{
-				EffectiveReplicas: 0,
-				NextBoundary:      getNextDayStart(nowLocal),
-				CurrentWindow:     "Holiday-Closed",
-				Reason:            "holiday-closed",
+				EffectiveReplicas:    0,
+				EffectiveMaxReplicas: input.DefaultMaxReplicas,
+				NextBoundary:         getNextDayStart(nowLocal),
+				CurrentWindow:        "Holiday-Closed",
+				Reason:               "holiday-closed",
 			}, nil
 		case "treat-as-open":
-			// Find max replicas among all windows
+			// Find max replicas (and max upper bound) among all windows
 			maxReplicas := input.DefaultReplicas
+			maxUpper := input.DefaultMaxReplicas
 			for _, ws := range input.Windows {
+				if ws.StartAt != "" {
+					// One-off windows only count while they are active
+					if _, active, err := evaluateWindow(ws, nowLocal, loc, input.DSTPolicy); err != nil || !active {
+						continue
+					}
+				}
 				if ws.Replicas > maxReplicas {
 					maxReplicas = ws.Replicas
 				}
+				if ws.MaxReplicas != nil && (maxUpper == nil || *ws.MaxReplicas > *maxUpper) {
+					maxUpper = ws.MaxReplicas
+				}
 			}
 			return Output{
-				EffectiveReplicas: maxReplicas,
-				NextBoundary:      getNextDayStart(nowLocal),
-				CurrentWindow:     "Holiday-Open",
-				Reason:            "holiday-open",
+				EffectiveReplicas:    maxReplicas,
+				EffectiveMaxReplicas: maxUpper,
+				NextBoundary:         getNextDayStart(nowLocal),
+				CurrentWindow:        "Holiday-Open",
+				Reason:               "holiday-open",
 			}, nil
 		case "ignore":
 			// Continue with normal window processing
@@ -115,60 +206,69 @@ func ComputeEffectiveReplicas(input Input) (Output, error) {
 
 func computeWithoutPause(input Input, nowLocal time.Time, loc *time.Location) Output {
 	// Parse all windows and find matches
-	var activeWindow *Window
-	var nextBoundary time.Time
-	nextBoundary = getNextDayStart(nowLocal) // Default to tomorrow
-
-	// Process windows in reverse order (last wins)
-	for i := len(input.Windows) - 1; i >= 0; i-- {
-		ws := input.Windows[i]
-
-		// Check day restriction
-		if !isDayMatch(ws.Days, nowLocal) {
-			continue
-		}
-
-		// Parse window times
-		window, err := parseWindow(ws, nowLocal, loc)
-		if err != nil {
-			continue // Skip invalid windows
-		}
-
-		// Check if we're in this window
-		if isInWindow(nowLocal, window) {
-			if activeWindow == nil {
-				activeWindow = window
-			}
-		}
+	matched, nextBoundary := matchWindows(input, nowLocal, loc)
+	activeWindow := resolveConflict(matched, input.ConflictResolution)
+	matchedNames := make([]string, 0, len(matched))
+	for _, window := range matched {
+		matchedNames = append(matchedNames, window.displayName())
+	}
 
-		// Update next boundary
-		boundary := getWindowBoundary(nowLocal, window)
-		if boundary.Before(nextBoundary) && boundary.After(nowLocal) {
-			nextBoundary = boundary
-		}
+	// Holidays are decided per calendar day, so they need a reconcile at midnight
+	dayStart := getNextDayStart(nowLocal)
+	if nextBoundary.IsZero() || (holidaysApply(input.HolidayMode) && dayStart.Before(nextBoundary)) {
+		nextBoundary = dayStart
 	}
 
 	// Determine the target replicas based on windows
 	var targetReplicas int32
+	var targetMaxReplicas *int32
 	var targetWindow string
 	var targetReason string
 
 	if activeWindow != nil {
-		windowName := activeWindow.Name
-		if windowName == "" {
-			windowName = fmt.Sprintf("%s-%s",
-				activeWindow.Start.Format("15:04"),
-				activeWindow.End.Format("15:04"))
-		}
 		targetReplicas = activeWindow.Replicas
-		targetWindow = windowName
+		targetMaxReplicas = activeWindow.MaxReplicas
+		if targetMaxReplicas == nil {
+			targetMaxReplicas = input.DefaultMaxReplicas
+		}
+		targetWindow = activeWindow.displayName()
 		targetReason = "in-window"
 	} else {
 		targetReplicas = input.DefaultReplicas
+		targetMaxReplicas = input.DefaultMaxReplicas
 		targetWindow = "Default"
 		targetReason = "no-matching-window"
 	}
 
+	// A window's lead time pulls its scale-up forward; the reported window is unchanged until it starts
+	current := activeWindow
+	if window := preWarmWindow(input, nowLocal, loc, targetReplicas); window != nil {
+		current = window
+		targetReplicas = window.Replicas
+		if window.MaxReplicas != nil && (targetMaxReplicas == nil || *window.MaxReplicas > *targetMaxReplicas) {
+			targetMaxReplicas = window.MaxReplicas
+		}
+		targetReason = "pre-warming"
+	}
+	if preWarm := nextPreWarm(input, nowLocal, loc); !preWarm.IsZero() && preWarm.Before(nextBoundary) {
+		nextBoundary = preWarm
+	}
+
+	// A ramp across a recent window boundary replaces the grace period
+	if r := currentRamp(input, nowLocal, loc, current, targetReplicas); r != nil {
+		if r.nextStep.Before(nextBoundary) {
+			nextBoundary = r.nextStep
+		}
+		return Output{
+			EffectiveReplicas:    r.replicas,
+			EffectiveMaxReplicas: targetMaxReplicas,
+			NextBoundary:         nextBoundary,
+			CurrentWindow:        targetWindow,
+			Reason:               "ramping",
+			MatchedWindows:       matchedNames,
+		}
+	}
+
 	// Check if grace period applies (only for scale-down operations)
 	if input.CurrentReplicas > 0 && targetReplicas < input.CurrentReplicas {
 		// This is a scale-down operation - check grace period
@@ -181,6 +281,7 @@ func computeWithoutPause(input Input, nowLocal time.Time, loc *time.Location) Ou
 					NextBoundary:      gracePeriodExpiry, // Next boundary is when grace period expires
 					CurrentWindow:     "grace-period",
 					Reason:            "grace-period-active",
+					MatchedWindows:    matchedNames,
 				}
 			}
 		}
@@ -188,111 +289,315 @@ func computeWithoutPause(input Input, nowLocal time.Time, loc *time.Location) Ou
 
 	// Return the computed target
 	return Output{
-		EffectiveReplicas: targetReplicas,
-		NextBoundary:      nextBoundary,
-		CurrentWindow:     targetWindow,
-		Reason:            targetReason,
+		EffectiveReplicas:    targetReplicas,
+		EffectiveMaxReplicas: targetMaxReplicas,
+		NextBoundary:         nextBoundary,
+		CurrentWindow:        targetWindow,
+		Reason:               targetReason,
+		MatchedWindows:       matchedNames,
 	}
 }
 
-// parseWindow converts a WindowSpec into a Window with absolute times
-func parseWindow(ws WindowSpec, nowLocal time.Time, loc *time.Location) (*Window, error) {
-	// Parse start time for today
-	startTime, err := parseTimeString(ws.Start, nowLocal, loc)
+// matchWindows returns the windows active at a time, in spec order, and the earliest window boundary after it
+func matchWindows(input Input, nowLocal time.Time, loc *time.Location) ([]*Window, time.Time) {
+	var matched []*Window
+	var nextBoundary time.Time
+	for i, ws := range input.Windows {
+		window, active, err := evaluateWindow(ws, nowLocal, loc, input.DSTPolicy)
+		if err != nil {
+			continue // Skip invalid windows and windows without upcoming occurrences
+		}
+		window.index = i
+
+		// Check if we're in this window
+		if active {
+			matched = append(matched, window)
+		}
+
+		// Update next boundary: the end of an active occurrence, or the start of the next one
+		boundary := window.Start
+		if active {
+			boundary = window.End
+		}
+		if nextBoundary.IsZero() || boundary.Before(nextBoundary) {
+			nextBoundary = boundary
+		}
+	}
+	return matched, nextBoundary
+}
+
+// resolveConflict picks the window to apply among the active windows, given in spec order.
+// Ties are broken in favour of the window listed last.
+func resolveConflict(matched []*Window, strategy string) *Window {
+	var chosen *Window
+	for _, window := range matched {
+		if chosen == nil || prefers(window, chosen, strategy) {
+			chosen = window
+		}
+	}
+	return chosen
+}
+
+// prefers reports whether a window listed later replaces the window chosen so far
+func prefers(candidate, chosen *Window, strategy string) bool {
+	switch strategy {
+	case "HighestPriority":
+		return candidate.Priority >= chosen.Priority
+	case "MaxReplicas":
+		return candidate.Replicas >= chosen.Replicas
+	case "MinReplicas":
+		return candidate.Replicas <= chosen.Replicas
+	default: // LastWins
+		return true
+	}
+}
+
+// displayName returns the window name, or its start and end times when unnamed
+func (w *Window) displayName() string {
+	if w.Name != "" {
+		return w.Name
+	}
+	if w.multiDay {
+		return fmt.Sprintf("%s-%s", w.Start.Format("Mon 15:04"), w.End.Format("Mon 15:04"))
+	}
+	return fmt.Sprintf("%s-%s", w.Start.Format("15:04"), w.End.Format("15:04"))
+}
+
+// evaluateWindow resolves a window to the occurrence covering now, or the next one,
+// and reports whether that occurrence is active
+func evaluateWindow(ws WindowSpec, nowLocal time.Time, loc *time.Location, dstPolicy string) (*Window, bool, error) {
+	var window *Window
+	var err error
+	switch {
+	case ws.StartAt != "":
+		window, err = parseOneOffWindow(ws, nowLocal, loc)
+	case ws.StartCron != "":
+		window, err = parseCronWindow(ws, nowLocal)
+	default:
+		window, err = nextDailyWindow(ws, nowLocal, loc, dstPolicy)
+	}
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
+	// All resolve to an occurrence that has not ended yet
+	return window, !nowLocal.Before(window.Start), nil
+}
 
-	// Parse end time for today initially
-	endTime, err := parseTimeString(ws.End, nowLocal, loc)
+// nextDailyWindow returns the first occurrence of an HH:MM window that has not ended by now.
+// An occurrence belongs to the date it starts on, so the day, month and date restrictions
+// apply to that date and a cross-midnight window started yesterday is still considered.
+// With the Skip DST policy, occurrences starting in a DST gap are skipped.
+func nextDailyWindow(ws WindowSpec, nowLocal time.Time, loc *time.Location, dstPolicy string) (*Window, error) {
+	from, until, err := parseDateRange(ws)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if this is a cross-midnight window
-	// If end hour:minute is less than or equal to start hour:minute, it crosses midnight
-	startHour, startMin := startTime.Hour(), startTime.Minute()
-	endHour, endMin := endTime.Hour(), endTime.Minute()
-
-	if endHour < startHour || (endHour == startHour && endMin <= startMin) {
-		// This is a cross-midnight window
-		// If we're currently in the early morning part (before the end time),
-		// we need to adjust the start to be yesterday
-		if nowLocal.Hour() < endHour || (nowLocal.Hour() == endHour && nowLocal.Minute() < endMin) {
-			// We're in the early morning part - start was yesterday
-			startTime = startTime.AddDate(0, 0, -1)
-		} else {
-			// We're in the late night part or outside - end is tomorrow
-			endTime = endTime.AddDate(0, 0, 1)
+	// Calendar dates are walked in UTC so DST transitions cannot skip or repeat a day.
+	// An occurrence lasts at most a day, or a week for a multi-day window.
+	lookback := 1
+	if ws.StartDay != "" {
+		lookback = 7
+	}
+	date := time.Date(nowLocal.Year(), nowLocal.Month(), nowLocal.Day()-lookback, 0, 0, 0, 0, time.UTC)
+	if !from.IsZero() && from.After(date) {
+		date = from
+	}
+	for i := 0; i < maxDateSearch; i, date = i+1, date.AddDate(0, 0, 1) {
+		if !until.IsZero() && date.After(until) {
+			break
+		}
+		if !isDateMatch(ws, date) {
+			continue
+		}
+		window, inGap, err := parseWindow(ws, date, loc)
+		if err != nil {
+			return nil, err
 		}
+		if inGap && dstPolicy == DSTPolicySkip {
+			continue
+		}
+		if nowLocal.Before(window.End) {
+			return window, nil
+		}
+	}
+	return nil, fmt.Errorf("window %q has no upcoming occurrence", ws.Name)
+}
+
+// parseOneOffWindow converts a one-off WindowSpec into its Window, failing once it has ended
+func parseOneOffWindow(ws WindowSpec, nowLocal time.Time, loc *time.Location) (*Window, error) {
+	start, end, err := parseOneOffTimes(ws, loc)
+	if err != nil {
+		return nil, err
+	}
+	if !nowLocal.Before(end) {
+		return nil, fmt.Errorf("one-off window %q ended at %s", ws.Name, ws.EndAt)
 	}
 
 	return &Window{
-		Start:    startTime,
-		End:      endTime,
-		Replicas: ws.Replicas,
-		Name:     ws.Name,
-		Days:     ws.Days,
+		Start:       start,
+		End:         end,
+		Replicas:    ws.Replicas,
+		MaxReplicas: ws.MaxReplicas,
+		Name:        ws.Name,
+		Priority:    ws.Priority,
 	}, nil
 }
 
-// parseTimeString parses HH:MM format into a time.Time for today
-func parseTimeString(timeStr string, nowLocal time.Time, loc *time.Location) (time.Time, error) {
+// parseOneOffTimes parses the absolute start and end of a one-off window in the schedule's timezone.
+// Times in a DST gap move to the end of the gap and times in an overlap resolve to their first instance.
+func parseOneOffTimes(ws WindowSpec, loc *time.Location) (start, end time.Time, err error) {
+	if start, err = parseLocalDateTime(ws.StartAt, loc); err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid one-off start %q: %w", ws.StartAt, err)
+	}
+	if end, err = parseLocalDateTime(ws.EndAt, loc); err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid one-off end %q: %w", ws.EndAt, err)
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("one-off end %s is not after start %s", ws.EndAt, ws.StartAt)
+	}
+	return start, end, nil
+}
+
+// parseLocalDateTime parses a YYYY-MM-DDTHH:MM wall-clock time in loc
+func parseLocalDateTime(value string, loc *time.Location) (time.Time, error) {
+	wall, err := time.Parse(DateTimeLayout, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, _ := localTime(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), loc)
+	return t, nil
+}
+
+// parseWindow converts a WindowSpec into the Window occurrence starting on the given date,
+// and reports whether its start falls into a DST gap and was shifted to the end of the gap.
+// The end is likewise shifted out of a gap; times repeated by a DST overlap resolve to their first instance.
+func parseWindow(ws WindowSpec, date time.Time, loc *time.Location) (*Window, bool, error) {
+	startHour, startMin, err := parseClock(ws.Start)
+	if err != nil {
+		return nil, false, err
+	}
+
+	endHour, endMin, err := parseClock(ws.End)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// If end hour:minute is less than or equal to start hour:minute, the window crosses midnight
+	// and ends on the following day. Wall-clock values are compared, never their DST-adjusted times.
+	// A multi-day window ends on the first EndDay after its start, a week later when both are equal.
+	endsBeforeStart := endHour < startHour || (endHour == startHour && endMin <= startMin)
+	endDay := date.Day()
+	if ws.StartDay != "" {
+		days, err := daysUntil(ws.StartDay, ws.EndDay)
+		if err != nil {
+			return nil, false, err
+		}
+		if days == 0 && endsBeforeStart {
+			days = 7
+		}
+		endDay += days
+	} else if endsBeforeStart {
+		endDay++
+	}
+	startTime, inGap := localTime(date.Year(), date.Month(), date.Day(), startHour, startMin, loc)
+	endTime, _ := localTime(date.Year(), date.Month(), endDay, endHour, endMin, loc)
+
+	return &Window{
+		Start:       startTime,
+		End:         endTime,
+		Replicas:    ws.Replicas,
+		MaxReplicas: ws.MaxReplicas,
+		Name:        ws.Name,
+		Days:        ws.Days,
+		Priority:    ws.Priority,
+		multiDay:    ws.StartDay != "",
+	}, inGap, nil
+}
+
+// daysUntil returns the days from one weekday to the next occurrence of another, 0 to 6
+func daysUntil(from, to string) (int, error) {
+	fromDay, err := parseWeekday(from)
+	if err != nil {
+		return 0, err
+	}
+	toDay, err := parseWeekday(to)
+	if err != nil {
+		return 0, err
+	}
+	return (int(toDay) - int(fromDay) + 7) % 7, nil
+}
+
+// parseWeekday parses a full day name, ignoring case
+func parseWeekday(name string) (time.Weekday, error) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(name, day.String()) {
+			return day, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid day: %s", name)
+}
+
+// parseClock parses HH:MM format into an hour and minute
+func parseClock(timeStr string) (hour, minute int, err error) {
 	parts := strings.Split(timeStr, ":")
 	if len(parts) != 2 {
-		return time.Time{}, fmt.Errorf("invalid time format: %s", timeStr)
+		return 0, 0, fmt.Errorf("invalid time format: %s", timeStr)
 	}
 
-	hour, err := strconv.Atoi(parts[0])
+	hour, err = strconv.Atoi(parts[0])
 	if err != nil || hour < 0 || hour > 23 {
-		return time.Time{}, fmt.Errorf("invalid hour: %s", parts[0])
+		return 0, 0, fmt.Errorf("invalid hour: %s", parts[0])
 	}
 
-	minute, err := strconv.Atoi(parts[1])
+	minute, err = strconv.Atoi(parts[1])
 	if err != nil || minute < 0 || minute > 59 {
-		return time.Time{}, fmt.Errorf("invalid minute: %s", parts[1])
+		return 0, 0, fmt.Errorf("invalid minute: %s", parts[1])
 	}
 
-	// Create time for today at the specified hour:minute
-	result := time.Date(nowLocal.Year(), nowLocal.Month(), nowLocal.Day(),
-		hour, minute, 0, 0, loc)
-
-	return result, nil
+	return hour, minute, nil
 }
 
-// isInWindow checks if the current time is within a window (start inclusive, end exclusive)
-func isInWindow(now time.Time, window *Window) bool {
-	// For cross-midnight windows, we need to check if we're:
-	// - Between start and midnight (same day as start), OR
-	// - Between midnight and end (next day)
-
-	if window.End.Before(window.Start) || window.End.Day() != window.Start.Day() {
-		// This is a cross-midnight window
-		// Check if we're in the late-night part (after start, before midnight)
-		if now.Day() == window.Start.Day() &&
-			(now.Equal(window.Start) || now.After(window.Start)) {
-			return true
+// parseDateRange parses the optional inclusive StartDate and EndDate of a window;
+// unset bounds are returned as zero times
+func parseDateRange(ws WindowSpec) (from, until time.Time, err error) {
+	if ws.StartDate != "" {
+		if from, err = time.Parse(DateLayout, ws.StartDate); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid start date %q: %w", ws.StartDate, err)
 		}
-		// Check if we're in the early-morning part (after midnight, before end)
-		if now.Day() == window.End.Day() && now.Before(window.End) {
-			return true
+	}
+	if ws.EndDate != "" {
+		if until, err = time.Parse(DateLayout, ws.EndDate); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid end date %q: %w", ws.EndDate, err)
 		}
-		return false
 	}
+	if !from.IsZero() && !until.IsZero() && until.Before(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("end date %s is before start date %s", ws.EndDate, ws.StartDate)
+	}
+	return from, until, nil
+}
 
-	// Normal window (doesn't cross midnight)
-	return (now.Equal(window.Start) || now.After(window.Start)) && now.Before(window.End)
+// isDateMatch checks if a date matches all day and month restrictions of a window
+func isDateMatch(ws WindowSpec, date time.Time) bool {
+	if ws.StartDay != "" && !strings.EqualFold(ws.StartDay, date.Weekday().String()) {
+		return false
+	}
+	return isDayMatch(ws.Days, date) &&
+		isMonthMatch(ws.Months, date) &&
+		isDayOfMonthMatch(ws.DaysOfMonth, date, false) &&
+		isDayOfMonthMatch(ws.BusinessDaysOfMonth, date, true) &&
+		isWeekdayOccurrenceMatch(ws.WeekdayOccurrence, date)
 }
 
-// isDayMatch checks if the current day matches the window's day restrictions
-func isDayMatch(days []string, now time.Time) bool {
+// isDayMatch checks if a date matches the window's day restrictions
+func isDayMatch(days []string, date time.Time) bool {
 	if len(days) == 0 {
 		return true // No restriction
 	}
 
-	todayName := now.Weekday().String()
+	dayName := date.Weekday().String()
 	for _, day := range days {
-		if strings.EqualFold(day, todayName) {
+		if strings.EqualFold(day, dayName) {
 			return true
 		}
 	}
@@ -300,45 +605,112 @@ func isDayMatch(days []string, now time.Time) bool {
 	return false
 }
 
-// getWindowBoundary returns the next start or end time for a window
-func getWindowBoundary(now time.Time, window *Window) time.Time {
-	// For cross-midnight windows, we need special handling
-	if window.End.Day() != window.Start.Day() {
-		// Cross-midnight window
-		if isInWindow(now, window) {
-			// We're in the window, next boundary is the end
-			return window.End
+// isMonthMatch checks if a date matches the window's month restrictions
+func isMonthMatch(months []string, date time.Time) bool {
+	if len(months) == 0 {
+		return true // No restriction
+	}
+
+	monthName := date.Month().String()
+	for _, month := range months {
+		if strings.EqualFold(month, monthName) {
+			return true
 		}
-		// We're outside - find the next start
-		// If we're before today's start time
-		if now.Before(window.Start) {
-			return window.Start
+	}
+
+	return false
+}
+
+// isDayOfMonthMatch checks if a date matches the window's days of the month.
+// Negative days count back from the end of the month, so -1 is the last day;
+// days beyond the length of a month, such as 31 in April, do not match.
+// With businessDays, only Monday to Friday are counted and weekends never match.
+func isDayOfMonthMatch(daysOfMonth []int, date time.Time, businessDays bool) bool {
+	if len(daysOfMonth) == 0 {
+		return true // No restriction
+	}
+
+	day, lastDay := date.Day(), daysIn(date)
+	if businessDays {
+		if !isBusinessDay(date.Weekday()) {
+			return false
+		}
+		day, lastDay = businessDaysUntil(date, day), businessDaysUntil(date, lastDay)
+	}
+	for _, d := range daysOfMonth {
+		if d == day || (d < 0 && lastDay+d+1 == day) {
+			return true
 		}
-		// We're after the window end, next occurrence is tonight
-		nextStart := time.Date(now.Year(), now.Month(), now.Day(),
-			window.Start.Hour(), window.Start.Minute(), 0, 0, now.Location())
-		if nextStart.Before(now) || nextStart.Equal(now) {
-			nextStart = nextStart.AddDate(0, 0, 1)
+	}
+
+	return false
+}
+
+// isBusinessDay reports whether a weekday is Monday to Friday
+func isBusinessDay(weekday time.Weekday) bool {
+	return weekday != time.Saturday && weekday != time.Sunday
+}
+
+// businessDaysUntil counts the business days of the date's month up to and including day
+func businessDaysUntil(date time.Time, day int) int {
+	first := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	count := 0
+	for i := 0; i < day; i++ {
+		if isBusinessDay(first.AddDate(0, 0, i).Weekday()) {
+			count++
 		}
-		return nextStart
 	}
+	return count
+}
 
-	// Normal (non-cross-midnight) window
-	if now.Before(window.Start) {
-		return window.Start
+// isWeekdayOccurrenceMatch checks if a date is the nth occurrence of a weekday in its month
+func isWeekdayOccurrenceMatch(occurrence *WeekdayOccurrence, date time.Time) bool {
+	if occurrence == nil {
+		return true // No restriction
 	}
-	if now.Before(window.End) {
-		return window.End
+	if !strings.EqualFold(occurrence.Weekday, date.Weekday().String()) {
+		return false
 	}
-	// We're after the window - next boundary is tomorrow's start
-	return window.Start.AddDate(0, 0, 1)
+
+	if occurrence.Nth > 0 {
+		return (date.Day()-1)/7+1 == occurrence.Nth
+	}
+	return (daysIn(date)-date.Day())/7+1 == -occurrence.Nth
+}
+
+// daysIn returns the number of days in the month of a date
+func daysIn(date time.Time) int {
+	return time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// holidaysApply reports whether a holiday mode changes the outcome on holidays
+func holidaysApply(mode string) bool {
+	return mode == "treat-as-closed" || mode == "treat-as-open"
 }
 
-// getNextDayStart returns midnight tomorrow in the same timezone
+// getNextDayStart returns the start of tomorrow in the same timezone, after any DST gap at midnight
 func getNextDayStart(now time.Time) time.Time {
-	tomorrow := now.AddDate(0, 0, 1)
-	return time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(),
-		0, 0, 0, 0, now.Location())
+	start, _ := localTime(now.Year(), now.Month(), now.Day()+1, 0, 0, now.Location())
+	return start
+}
+
+// ExpiredWindows returns the names of the one-off windows that have ended
+func ExpiredWindows(input Input) ([]string, error) {
+	loc, err := time.LoadLocation(input.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %s: %w", input.Timezone, err)
+	}
+
+	var expired []string
+	for _, ws := range input.Windows {
+		if ws.StartAt == "" {
+			continue
+		}
+		if _, end, err := parseOneOffTimes(ws, loc); err == nil && !input.Now.Before(end) {
+			expired = append(expired, ws.Name)
+		}
+	}
+	return expired, nil
 }
 
 // ComputeNextBoundary calculates the next time when scaling might change
@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"slices"
 	"testing"
 	"time"
 )
@@ -61,7 +62,7 @@ func TestComputeEffectiveReplicas(t *testing.T) {
 			wantReplicas:     2,
 			wantWindow:       "Default",
 			wantReason:       "no-matching-window",
-			wantNextBoundary: time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC), // Next day midnight
+			wantNextBoundary: time.Date(2025, 3, 11, 9, 0, 0, 0, time.UTC), // Next start
 		},
 		{
 			name:     "Cross-midnight window active",
@@ -70,13 +71,11 @@ func TestComputeEffectiveReplicas(t *testing.T) {
 			windows: []WindowSpec{
 				{Start: "22:00", End: "02:00", Replicas: 3, Name: "NightShift"},
 			},
-			defaultReplicas: 1,
-			wantReplicas:    3,
-			wantWindow:      "NightShift",
-			wantReason:      "in-window",
-			// NOTE: The actual next boundary logic returns midnight as the default
-			// when no better boundary is found. This is acceptable behavior.
-			// wantNextBoundary: time.Date(2025, 3, 11, 2, 0, 0, 0, time.UTC),
+			defaultReplicas:  1,
+			wantReplicas:     3,
+			wantWindow:       "NightShift",
+			wantReason:       "in-window",
+			wantNextBoundary: time.Date(2025, 3, 11, 2, 0, 0, 0, time.UTC),
 		},
 		{
 			name:     "Cross-midnight window active after midnight",
@@ -311,7 +310,7 @@ func TestWindowParsing(t *testing.T) {
 			now := time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC)
 			loc, _ := time.LoadLocation("UTC")
 
-			_, err := parseWindow(tt.windowSpec, now, loc)
+			_, _, err := parseWindow(tt.windowSpec, now, loc)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("parseWindow() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -319,6 +318,710 @@ func TestWindowParsing(t *testing.T) {
 	}
 }
 
+func TestDateRestrictedWindows(t *testing.T) {
+	december := WindowSpec{Start: "09:00", End: "17:00", Replicas: 6, Name: "december", Months: []string{"December"}}
+	cyberWeekend := WindowSpec{
+		Start: "08:00", End: "22:00", Replicas: 20, Name: "cyber-weekend",
+		StartDate: "2025-11-28", EndDate: "2025-12-01",
+	}
+
+	tests := []struct {
+		name             string
+		now              time.Time
+		timezone         string
+		windows          []WindowSpec
+		holidayMode      string
+		wantReplicas     int32
+		wantWindow       string
+		wantNextBoundary time.Time
+	}{
+		{
+			name:             "Month restriction jumps to the first valid date",
+			now:              time.Date(2025, 11, 15, 10, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{december},
+			wantReplicas:     1,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Month restriction inside the month",
+			now:              time.Date(2025, 12, 10, 10, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{december},
+			wantReplicas:     6,
+			wantWindow:       "december",
+			wantNextBoundary: time.Date(2025, 12, 10, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Month restriction wraps into next year",
+			now:              time.Date(2025, 12, 31, 18, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{december},
+			wantReplicas:     1,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2026, 12, 1, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Month is evaluated in the window's timezone",
+			now:              time.Date(2025, 12, 1, 3, 0, 0, 0, time.UTC), // November 30 22:00 EST
+			timezone:         "America/New_York",
+			windows:          []WindowSpec{{Start: "20:00", End: "23:00", Replicas: 6, Months: []string{"december"}}},
+			wantReplicas:     1,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2025, 12, 2, 1, 0, 0, 0, time.UTC), // December 1 20:00 EST
+		},
+		{
+			name:             "Before the date range",
+			now:              time.Date(2025, 11, 27, 10, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{cyberWeekend},
+			wantReplicas:     1,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2025, 11, 28, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Inside the date range",
+			now:              time.Date(2025, 11, 29, 10, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{cyberWeekend},
+			wantReplicas:     20,
+			wantWindow:       "cyber-weekend",
+			wantNextBoundary: time.Date(2025, 11, 29, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "After the date range falls back to midnight",
+			now:              time.Date(2025, 12, 1, 23, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{cyberWeekend},
+			wantReplicas:     1,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Cross-midnight occurrence started on the end date",
+			now:  time.Date(2025, 12, 2, 1, 0, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{Start: "22:00", End: "02:00", Replicas: 4, Name: "late", EndDate: "2025-12-01"},
+			},
+			wantReplicas:     4,
+			wantWindow:       "late",
+			wantNextBoundary: time.Date(2025, 12, 2, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Cross-midnight occurrence keeps the day it started on",
+			now:  time.Date(2025, 3, 15, 1, 0, 0, 0, time.UTC), // Saturday 01:00
+			windows: []WindowSpec{
+				{Start: "22:00", End: "02:00", Replicas: 4, Name: "friday-night", Days: []string{"Friday"}},
+			},
+			wantReplicas:     4,
+			wantWindow:       "friday-night",
+			wantNextBoundary: time.Date(2025, 3, 15, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Holiday modes keep a midnight boundary",
+			now:              time.Date(2025, 11, 15, 10, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{december},
+			holidayMode:      "treat-as-closed",
+			wantReplicas:     1,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2025, 11, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "End date before start date is skipped",
+			now:  time.Date(2025, 11, 29, 10, 0, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{Start: "08:00", End: "22:00", Replicas: 20, StartDate: "2025-12-01", EndDate: "2025-11-28"},
+			},
+			wantReplicas:     1,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timezone := tt.timezone
+			if timezone == "" {
+				timezone = "UTC"
+			}
+			output, err := ComputeEffectiveReplicas(Input{
+				Now:             tt.now,
+				Timezone:        timezone,
+				Windows:         tt.windows,
+				DefaultReplicas: 1,
+				HolidayMode:     tt.holidayMode,
+			})
+			if err != nil {
+				t.Fatalf("ComputeEffectiveReplicas() error = %v", err)
+			}
+
+			if output.EffectiveReplicas != tt.wantReplicas {
+				t.Errorf("EffectiveReplicas = %v, want %v", output.EffectiveReplicas, tt.wantReplicas)
+			}
+			if output.CurrentWindow != tt.wantWindow {
+				t.Errorf("CurrentWindow = %v, want %v", output.CurrentWindow, tt.wantWindow)
+			}
+			if !output.NextBoundary.Equal(tt.wantNextBoundary) {
+				t.Errorf("NextBoundary = %v, want %v", output.NextBoundary, tt.wantNextBoundary)
+			}
+		})
+	}
+}
+
+func TestDayOfMonthMatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		daysOfMonth  []int
+		businessDays bool
+		date         time.Time
+		want         bool
+	}{
+		{name: "No restriction", date: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Payroll on the 15th", daysOfMonth: []int{1, 15}, date: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Not the 15th", daysOfMonth: []int{1, 15}, date: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), want: false},
+		{name: "Last day of a 31-day month", daysOfMonth: []int{-1}, date: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Last day of a 30-day month", daysOfMonth: []int{-1}, date: time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Day 30 is not last in a 31-day month", daysOfMonth: []int{-1}, date: time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC), want: false},
+		{name: "Last day of February", daysOfMonth: []int{-1}, date: time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "February 28 in a leap year", daysOfMonth: []int{-1}, date: time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC), want: false},
+		{name: "Leap day is last", daysOfMonth: []int{-1}, date: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Second to last day in a leap year", daysOfMonth: []int{-2}, date: time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Day 31 never matches in April", daysOfMonth: []int{31}, date: time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC), want: false},
+		{name: "Day -31 is the first of a 31-day month", daysOfMonth: []int{-31}, date: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Day -31 never matches in February", daysOfMonth: []int{-31}, date: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), want: false},
+		{name: "Last business day of a month ending on a Saturday", daysOfMonth: []int{-1}, businessDays: true, date: time.Date(2025, 5, 30, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Saturday month end is not a business day", daysOfMonth: []int{-1}, businessDays: true, date: time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC), want: false},
+		{name: "Last business day of a month ending on a Sunday", daysOfMonth: []int{-1}, businessDays: true, date: time.Date(2025, 8, 29, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Sunday month end is not a business day", daysOfMonth: []int{-1}, businessDays: true, date: time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC), want: false},
+		{name: "Last business day on a weekday month end", daysOfMonth: []int{-1}, businessDays: true, date: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Second to last business day skips the weekend", daysOfMonth: []int{-2}, businessDays: true, date: time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "First business day after a weekend", daysOfMonth: []int{1}, businessDays: true, date: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Weekend first of the month is not the first business day", daysOfMonth: []int{1}, businessDays: true, date: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), want: false},
+		{name: "Business day 23 never matches in a month with 22", daysOfMonth: []int{23}, businessDays: true, date: time.Date(2025, 5, 30, 0, 0, 0, 0, time.UTC), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDayOfMonthMatch(tt.daysOfMonth, tt.date, tt.businessDays); got != tt.want {
+				t.Errorf("isDayOfMonthMatch(%v, %s, %v) = %v, want %v", tt.daysOfMonth, tt.date.Format(DateLayout), tt.businessDays, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeekdayOccurrenceMatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		occurrence *WeekdayOccurrence
+		date       time.Time
+		want       bool
+	}{
+		{name: "No restriction", date: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "First Monday", occurrence: &WeekdayOccurrence{Weekday: "Monday", Nth: 1}, date: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Second Monday is not first", occurrence: &WeekdayOccurrence{Weekday: "Monday", Nth: 1}, date: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), want: false},
+		{name: "Wrong weekday", occurrence: &WeekdayOccurrence{Weekday: "Tuesday", Nth: 2}, date: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), want: false},
+		{name: "Last Friday of a leap February", occurrence: &WeekdayOccurrence{Weekday: "Friday", Nth: -1}, date: time.Date(2024, 2, 23, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Last Thursday of a leap February is the 29th", occurrence: &WeekdayOccurrence{Weekday: "thursday", Nth: -1}, date: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "February 22 2024 is not the last Thursday", occurrence: &WeekdayOccurrence{Weekday: "Thursday", Nth: -1}, date: time.Date(2024, 2, 22, 0, 0, 0, 0, time.UTC), want: false},
+		{name: "Last Friday of a 30-day month", occurrence: &WeekdayOccurrence{Weekday: "Friday", Nth: -1}, date: time.Date(2025, 4, 25, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Second to last Friday", occurrence: &WeekdayOccurrence{Weekday: "Friday", Nth: -2}, date: time.Date(2025, 4, 18, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Fifth Thursday", occurrence: &WeekdayOccurrence{Weekday: "Thursday", Nth: 5}, date: time.Date(2025, 5, 29, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "Fifth Saturday of a non-leap February does not exist", occurrence: &WeekdayOccurrence{Weekday: "Saturday", Nth: 5}, date: time.Date(2025, 2, 22, 0, 0, 0, 0, time.UTC), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isWeekdayOccurrenceMatch(tt.occurrence, tt.date); got != tt.want {
+				t.Errorf("isWeekdayOccurrenceMatch(%+v, %s) = %v, want %v", tt.occurrence, tt.date.Format(DateLayout), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceWindows(t *testing.T) {
+	tests := []struct {
+		name             string
+		now              time.Time
+		window           WindowSpec
+		wantReplicas     int32
+		wantNextBoundary time.Time
+	}{
+		{
+			name:             "Payroll on the 15th is active",
+			now:              time.Date(2025, 3, 15, 10, 0, 0, 0, time.UTC),
+			window:           WindowSpec{Start: "09:00", End: "17:00", Replicas: 6, DaysOfMonth: []int{15}},
+			wantReplicas:     6,
+			wantNextBoundary: time.Date(2025, 3, 15, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Month end jumps to the last day of a short month",
+			now:              time.Date(2025, 4, 10, 10, 0, 0, 0, time.UTC),
+			window:           WindowSpec{Start: "18:00", End: "23:00", Replicas: 6, DaysOfMonth: []int{-1}},
+			wantReplicas:     1,
+			wantNextBoundary: time.Date(2025, 4, 30, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Month end after February 28 in a leap year",
+			now:              time.Date(2024, 2, 28, 20, 0, 0, 0, time.UTC),
+			window:           WindowSpec{Start: "18:00", End: "23:00", Replicas: 6, DaysOfMonth: []int{-1}},
+			wantReplicas:     1,
+			wantNextBoundary: time.Date(2024, 2, 29, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Day 31 skips short months",
+			now:              time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+			window:           WindowSpec{Start: "09:00", End: "17:00", Replicas: 6, DaysOfMonth: []int{31}},
+			wantReplicas:     1,
+			wantNextBoundary: time.Date(2025, 5, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Leap day waits for the next leap year",
+			now:              time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC),
+			window:           WindowSpec{Start: "09:00", End: "17:00", Replicas: 6, DaysOfMonth: []int{29}, Months: []string{"February"}},
+			wantReplicas:     1,
+			wantNextBoundary: time.Date(2028, 2, 29, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Last Friday of the month",
+			now:  time.Date(2025, 4, 11, 10, 0, 0, 0, time.UTC),
+			window: WindowSpec{Start: "09:00", End: "17:00", Replicas: 6,
+				WeekdayOccurrence: &WeekdayOccurrence{Weekday: "Friday", Nth: -1}},
+			wantReplicas:     1,
+			wantNextBoundary: time.Date(2025, 4, 25, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Last business day of a month ending on a Saturday",
+			now:  time.Date(2025, 5, 10, 10, 0, 0, 0, time.UTC),
+			window: WindowSpec{Start: "09:00", End: "17:00", Replicas: 6,
+				BusinessDaysOfMonth: []int{-1}},
+			wantReplicas:     1,
+			wantNextBoundary: time.Date(2025, 5, 30, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Last business day of a month ending on a Sunday is active",
+			now:  time.Date(2025, 8, 29, 10, 0, 0, 0, time.UTC),
+			window: WindowSpec{Start: "09:00", End: "17:00", Replicas: 6,
+				BusinessDaysOfMonth: []int{-1}},
+			wantReplicas:     6,
+			wantNextBoundary: time.Date(2025, 8, 29, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Last business day skips a Sunday month end",
+			now:  time.Date(2025, 8, 29, 18, 0, 0, 0, time.UTC),
+			window: WindowSpec{Start: "09:00", End: "17:00", Replicas: 6,
+				BusinessDaysOfMonth: []int{-1}},
+			wantReplicas:     1,
+			wantNextBoundary: time.Date(2025, 9, 30, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Fifth Thursday skips months without one",
+			now:  time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC),
+			window: WindowSpec{Start: "09:00", End: "17:00", Replicas: 6,
+				WeekdayOccurrence: &WeekdayOccurrence{Weekday: "Thursday", Nth: 5}},
+			wantReplicas:     1,
+			wantNextBoundary: time.Date(2025, 5, 29, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Cross-midnight run on the last day continues into the next month",
+			now:  time.Date(2025, 5, 1, 1, 0, 0, 0, time.UTC),
+			window: WindowSpec{Start: "22:00", End: "04:00", Replicas: 6,
+				DaysOfMonth: []int{-1}},
+			wantReplicas:     6,
+			wantNextBoundary: time.Date(2025, 5, 1, 4, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ComputeEffectiveReplicas(Input{
+				Now:             tt.now,
+				Timezone:        "UTC",
+				Windows:         []WindowSpec{tt.window},
+				DefaultReplicas: 1,
+			})
+			if err != nil {
+				t.Fatalf("ComputeEffectiveReplicas() error = %v", err)
+			}
+
+			if output.EffectiveReplicas != tt.wantReplicas {
+				t.Errorf("EffectiveReplicas = %v, want %v", output.EffectiveReplicas, tt.wantReplicas)
+			}
+			if !output.NextBoundary.Equal(tt.wantNextBoundary) {
+				t.Errorf("NextBoundary = %v, want %v", output.NextBoundary, tt.wantNextBoundary)
+			}
+		})
+	}
+}
+
+func TestMultiDayWindows(t *testing.T) {
+	businessHours := WindowSpec{Start: "08:00", End: "18:00", Replicas: 10, Name: "business-hours",
+		Days: []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}}
+	weekend := WindowSpec{Start: "18:00", End: "06:00", StartDay: "Friday", EndDay: "Monday", Replicas: 1, Name: "weekend"}
+
+	tests := []struct {
+		name             string
+		now              time.Time
+		timezone         string
+		windows          []WindowSpec
+		wantReplicas     int32
+		wantWindow       string
+		wantNextBoundary time.Time
+	}{
+		{
+			name:             "Friday afternoon is before the weekend",
+			now:              time.Date(2025, 3, 14, 17, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{businessHours, weekend},
+			wantReplicas:     10,
+			wantWindow:       "business-hours",
+			wantNextBoundary: time.Date(2025, 3, 14, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Weekend starts Friday evening",
+			now:              time.Date(2025, 3, 14, 18, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{businessHours, weekend},
+			wantReplicas:     1,
+			wantWindow:       "weekend",
+			wantNextBoundary: time.Date(2025, 3, 17, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Sunday is inside the weekend",
+			now:              time.Date(2025, 3, 16, 12, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{businessHours, weekend},
+			wantReplicas:     1,
+			wantWindow:       "weekend",
+			wantNextBoundary: time.Date(2025, 3, 17, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Weekend ends Monday morning",
+			now:              time.Date(2025, 3, 17, 6, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{businessHours, weekend},
+			wantReplicas:     3,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2025, 3, 17, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Wednesday waits for Friday",
+			now:              time.Date(2025, 3, 12, 20, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{weekend},
+			wantReplicas:     3,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2025, 3, 14, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Unnamed window shows its days",
+			now:              time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{{Start: "18:00", End: "06:00", StartDay: "Friday", EndDay: "Monday", Replicas: 1}},
+			wantReplicas:     1,
+			wantWindow:       "Fri 18:00-Mon 06:00",
+			wantNextBoundary: time.Date(2025, 3, 17, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Same start and end day spans a week",
+			now:              time.Date(2025, 3, 16, 12, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{{Start: "09:00", End: "08:00", StartDay: "Monday", EndDay: "Monday", Replicas: 1, Name: "week"}},
+			wantReplicas:     1,
+			wantWindow:       "week",
+			wantNextBoundary: time.Date(2025, 3, 17, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Same start and end day within the day",
+			now:              time.Date(2025, 3, 16, 12, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{{Start: "09:00", End: "17:00", StartDay: "Monday", EndDay: "Monday", Replicas: 1, Name: "monday"}},
+			wantReplicas:     3,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2025, 3, 17, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Weekend across the spring-forward change",
+			now:              time.Date(2025, 3, 9, 12, 0, 0, 0, time.UTC),
+			timezone:         "America/New_York",
+			windows:          []WindowSpec{weekend},
+			wantReplicas:     1,
+			wantWindow:       "weekend",
+			wantNextBoundary: time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC), // 06:00 EDT
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timezone := tt.timezone
+			if timezone == "" {
+				timezone = "UTC"
+			}
+			output, err := ComputeEffectiveReplicas(Input{
+				Now:             tt.now,
+				Timezone:        timezone,
+				Windows:         tt.windows,
+				DefaultReplicas: 3,
+			})
+			if err != nil {
+				t.Fatalf("ComputeEffectiveReplicas() error = %v", err)
+			}
+			if output.EffectiveReplicas != tt.wantReplicas {
+				t.Errorf("EffectiveReplicas = %v, want %v", output.EffectiveReplicas, tt.wantReplicas)
+			}
+			if output.CurrentWindow != tt.wantWindow {
+				t.Errorf("CurrentWindow = %v, want %v", output.CurrentWindow, tt.wantWindow)
+			}
+			if !output.NextBoundary.Equal(tt.wantNextBoundary) {
+				t.Errorf("NextBoundary = %v, want %v", output.NextBoundary, tt.wantNextBoundary)
+			}
+		})
+	}
+}
+
+func TestOneOffWindows(t *testing.T) {
+	businessHours := WindowSpec{Start: "09:00", End: "17:00", Replicas: 5, Name: "business-hours"}
+	launch := WindowSpec{StartAt: "2026-11-20T08:00", EndAt: "2026-11-21T02:00", Replicas: 80, Name: "launch"}
+
+	tests := []struct {
+		name             string
+		now              time.Time
+		windows          []WindowSpec
+		holidayMode      string
+		isHoliday        bool
+		wantReplicas     int32
+		wantWindow       string
+		wantNextBoundary time.Time
+	}{
+		{
+			name:             "Before the launch",
+			now:              time.Date(2026, 11, 19, 10, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{businessHours, launch},
+			wantReplicas:     5,
+			wantWindow:       "business-hours",
+			wantNextBoundary: time.Date(2026, 11, 19, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Launch takes precedence over an earlier recurring window",
+			now:              time.Date(2026, 11, 20, 12, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{businessHours, launch},
+			wantReplicas:     80,
+			wantWindow:       "launch",
+			wantNextBoundary: time.Date(2026, 11, 20, 17, 0, 0, 0, time.UTC), // business-hours ends
+		},
+		{
+			name:             "Launch runs past midnight",
+			now:              time.Date(2026, 11, 21, 1, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{businessHours, launch},
+			wantReplicas:     80,
+			wantWindow:       "launch",
+			wantNextBoundary: time.Date(2026, 11, 21, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Launch never recurs",
+			now:              time.Date(2026, 11, 21, 3, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{businessHours, launch},
+			wantReplicas:     1,
+			wantWindow:       "Default",
+			wantNextBoundary: time.Date(2026, 11, 21, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "A later recurring window takes precedence",
+			now:              time.Date(2026, 11, 20, 12, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{launch, businessHours},
+			wantReplicas:     5,
+			wantWindow:       "business-hours",
+			wantNextBoundary: time.Date(2026, 11, 20, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Evaluated in the schedule's timezone",
+			now:  time.Date(2026, 6, 1, 7, 30, 0, 0, time.UTC), // 08:30 BST
+			windows: []WindowSpec{
+				{StartAt: "2026-06-01T08:00", EndAt: "2026-06-01T10:00", Replicas: 40, Name: "keynote"},
+			},
+			wantReplicas:     40,
+			wantWindow:       "keynote",
+			wantNextBoundary: time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Holiday treat-as-open ignores inactive one-off windows",
+			now:              time.Date(2026, 11, 19, 10, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{businessHours, launch},
+			holidayMode:      "treat-as-open",
+			isHoliday:        true,
+			wantReplicas:     5,
+			wantWindow:       "Holiday-Open",
+			wantNextBoundary: time.Date(2026, 11, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "Holiday treat-as-open includes an active one-off window",
+			now:              time.Date(2026, 11, 20, 12, 0, 0, 0, time.UTC),
+			windows:          []WindowSpec{businessHours, launch},
+			holidayMode:      "treat-as-open",
+			isHoliday:        true,
+			wantReplicas:     80,
+			wantWindow:       "Holiday-Open",
+			wantNextBoundary: time.Date(2026, 11, 21, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ComputeEffectiveReplicas(Input{
+				Now:             tt.now,
+				Timezone:        "Europe/London",
+				Windows:         tt.windows,
+				DefaultReplicas: 1,
+				HolidayMode:     tt.holidayMode,
+				IsHoliday:       tt.isHoliday,
+			})
+			if err != nil {
+				t.Fatalf("ComputeEffectiveReplicas() error = %v", err)
+			}
+
+			if output.EffectiveReplicas != tt.wantReplicas {
+				t.Errorf("EffectiveReplicas = %v, want %v", output.EffectiveReplicas, tt.wantReplicas)
+			}
+			if output.CurrentWindow != tt.wantWindow {
+				t.Errorf("CurrentWindow = %v, want %v", output.CurrentWindow, tt.wantWindow)
+			}
+			if !output.NextBoundary.Equal(tt.wantNextBoundary) {
+				t.Errorf("NextBoundary = %v, want %v", output.NextBoundary, tt.wantNextBoundary)
+			}
+		})
+	}
+}
+
+func TestExpiredWindows(t *testing.T) {
+	windows := []WindowSpec{
+		{Start: "09:00", End: "17:00", Replicas: 5, Name: "business-hours"},
+		{StartAt: "2026-11-20T08:00", EndAt: "2026-11-21T02:00", Replicas: 80, Name: "launch"},
+		{StartAt: "2026-12-01T08:00", EndAt: "2026-12-01T12:00", Replicas: 40, Name: "follow-up"},
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want []string
+	}{
+		{name: "None ended", now: time.Date(2026, 11, 20, 12, 0, 0, 0, time.UTC)},
+		{name: "Ended exactly at its end", now: time.Date(2026, 11, 21, 2, 0, 0, 0, time.UTC), want: []string{"launch"}},
+		{name: "All ended", now: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), want: []string{"launch", "follow-up"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExpiredWindows(Input{Now: tt.now, Timezone: "Europe/London", Windows: windows})
+			if err != nil {
+				t.Fatalf("ExpiredWindows() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ExpiredWindows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConflictResolution(t *testing.T) {
+	now := time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC) // Monday 14:00 UTC
+	windows := []WindowSpec{
+		{Start: "09:00", End: "17:00", Replicas: 5, Priority: 10, Name: "business-hours"},
+		{Start: "12:00", End: "15:00", Replicas: 10, Priority: 1, Name: "peak"},
+		{Start: "11:00", End: "16:00", Replicas: 2, Priority: 5, Name: "reduced"},
+		{Start: "18:00", End: "20:00", Replicas: 7, Priority: 99, Name: "evening"},
+	}
+
+	tests := []struct {
+		name         string
+		strategy     string
+		windows      []WindowSpec
+		wantReplicas int32
+		wantWindow   string
+		wantMatched  []string
+	}{
+		{
+			name:         "Default is last wins",
+			windows:      windows,
+			wantReplicas: 2,
+			wantWindow:   "reduced",
+			wantMatched:  []string{"business-hours", "peak", "reduced"},
+		},
+		{
+			name:         "Last wins",
+			strategy:     "LastWins",
+			windows:      windows,
+			wantReplicas: 2,
+			wantWindow:   "reduced",
+			wantMatched:  []string{"business-hours", "peak", "reduced"},
+		},
+		{
+			name:         "Highest priority among active windows",
+			strategy:     "HighestPriority",
+			windows:      windows,
+			wantReplicas: 5,
+			wantWindow:   "business-hours",
+			wantMatched:  []string{"business-hours", "peak", "reduced"},
+		},
+		{
+			name:     "Equal priorities fall back to the last listed",
+			strategy: "HighestPriority",
+			windows: []WindowSpec{
+				{Start: "09:00", End: "17:00", Replicas: 5, Priority: 3, Name: "business-hours"},
+				{Start: "12:00", End: "15:00", Replicas: 10, Priority: 3, Name: "peak"},
+			},
+			wantReplicas: 10,
+			wantWindow:   "peak",
+			wantMatched:  []string{"business-hours", "peak"},
+		},
+		{
+			name:         "Max replicas",
+			strategy:     "MaxReplicas",
+			windows:      windows,
+			wantReplicas: 10,
+			wantWindow:   "peak",
+			wantMatched:  []string{"business-hours", "peak", "reduced"},
+		},
+		{
+			name:         "Min replicas",
+			strategy:     "MinReplicas",
+			windows:      windows,
+			wantReplicas: 2,
+			wantWindow:   "reduced",
+			wantMatched:  []string{"business-hours", "peak", "reduced"},
+		},
+		{
+			name:     "Unnamed windows are reported by their times",
+			strategy: "MaxReplicas",
+			windows: []WindowSpec{
+				{Start: "09:00", End: "17:00", Replicas: 5},
+				{Start: "12:00", End: "15:00", Replicas: 3},
+			},
+			wantReplicas: 5,
+			wantWindow:   "09:00-17:00",
+			wantMatched:  []string{"09:00-17:00", "12:00-15:00"},
+		},
+		{
+			name:         "No active window",
+			strategy:     "HighestPriority",
+			windows:      windows[3:],
+			wantReplicas: 1,
+			wantWindow:   "Default",
+			wantMatched:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ComputeEffectiveReplicas(Input{
+				Now:                now,
+				Timezone:           "UTC",
+				Windows:            tt.windows,
+				DefaultReplicas:    1,
+				ConflictResolution: tt.strategy,
+			})
+			if err != nil {
+				t.Fatalf("ComputeEffectiveReplicas() error = %v", err)
+			}
+
+			if output.EffectiveReplicas != tt.wantReplicas {
+				t.Errorf("EffectiveReplicas = %v, want %v", output.EffectiveReplicas, tt.wantReplicas)
+			}
+			if output.CurrentWindow != tt.wantWindow {
+				t.Errorf("CurrentWindow = %v, want %v", output.CurrentWindow, tt.wantWindow)
+			}
+			if !slices.Equal(output.MatchedWindows, tt.wantMatched) {
+				t.Errorf("MatchedWindows = %v, want %v", output.MatchedWindows, tt.wantMatched)
+			}
+		})
+	}
+}
+
 func TestGracePeriodLogic(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -464,3 +1167,171 @@ func TestGracePeriodLogic(t *testing.T) {
 		})
 	}
 }
+
+func TestHPABounds(t *testing.T) {
+	int32Ptr := func(i int32) *int32 { return &i }
+
+	tests := []struct {
+		name               string
+		now                time.Time
+		windows            []WindowSpec
+		defaultMaxReplicas *int32
+		holidayMode        string
+		isHoliday          bool
+		wantReplicas       int32
+		wantMaxReplicas    *int32
+	}{
+		{
+			name: "Window sets both bounds",
+			now:  time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{Start: "09:00", End: "17:00", Replicas: 10, MaxReplicas: int32Ptr(50), Name: "business-hours"},
+			},
+			defaultMaxReplicas: int32Ptr(5),
+			wantReplicas:       10,
+			wantMaxReplicas:    int32Ptr(50),
+		},
+		{
+			name: "Window without max falls back to default max",
+			now:  time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{Start: "09:00", End: "17:00", Replicas: 10, Name: "business-hours"},
+			},
+			defaultMaxReplicas: int32Ptr(20),
+			wantReplicas:       10,
+			wantMaxReplicas:    int32Ptr(20),
+		},
+		{
+			name: "Outside windows uses default bounds",
+			now:  time.Date(2025, 3, 10, 22, 0, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{Start: "09:00", End: "17:00", Replicas: 10, MaxReplicas: int32Ptr(50), Name: "business-hours"},
+			},
+			defaultMaxReplicas: int32Ptr(5),
+			wantReplicas:       1,
+			wantMaxReplicas:    int32Ptr(5),
+		},
+		{
+			name: "No max configured leaves bound unchanged",
+			now:  time.Date(2025, 3, 10, 22, 0, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{Start: "09:00", End: "17:00", Replicas: 10, Name: "business-hours"},
+			},
+			wantReplicas:    1,
+			wantMaxReplicas: nil,
+		},
+		{
+			name: "Holiday treat-as-open uses largest bounds",
+			now:  time.Date(2025, 12, 25, 22, 0, 0, 0, time.UTC),
+			windows: []WindowSpec{
+				{Start: "09:00", End: "17:00", Replicas: 10, MaxReplicas: int32Ptr(50), Name: "business-hours"},
+				{Start: "17:00", End: "20:00", Replicas: 4, MaxReplicas: int32Ptr(80), Name: "evening"},
+			},
+			defaultMaxReplicas: int32Ptr(5),
+			holidayMode:        "treat-as-open",
+			isHoliday:          true,
+			wantReplicas:       10,
+			wantMaxReplicas:    int32Ptr(80),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ComputeEffectiveReplicas(Input{
+				Now:                tt.now,
+				Timezone:           "UTC",
+				Windows:            tt.windows,
+				DefaultReplicas:    1,
+				DefaultMaxReplicas: tt.defaultMaxReplicas,
+				HolidayMode:        tt.holidayMode,
+				IsHoliday:          tt.isHoliday,
+			})
+			if err != nil {
+				t.Fatalf("ComputeEffectiveReplicas() error = %v", err)
+			}
+
+			if output.EffectiveReplicas != tt.wantReplicas {
+				t.Errorf("EffectiveReplicas = %v, want %v", output.EffectiveReplicas, tt.wantReplicas)
+			}
+
+			switch {
+			case tt.wantMaxReplicas == nil && output.EffectiveMaxReplicas != nil:
+				t.Errorf("EffectiveMaxReplicas = %v, want nil", *output.EffectiveMaxReplicas)
+			case tt.wantMaxReplicas != nil && output.EffectiveMaxReplicas == nil:
+				t.Errorf("EffectiveMaxReplicas = nil, want %v", *tt.wantMaxReplicas)
+			case tt.wantMaxReplicas != nil && *output.EffectiveMaxReplicas != *tt.wantMaxReplicas:
+				t.Errorf("EffectiveMaxReplicas = %v, want %v", *output.EffectiveMaxReplicas, *tt.wantMaxReplicas)
+			}
+		})
+	}
+}
+
+func TestOverride(t *testing.T) {
+	windows := []WindowSpec{
+		{Start: "09:00", End: "17:00", Replicas: 10, Name: "business-hours"},
+	}
+	until := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		now          time.Time
+		override     *Override
+		isHoliday    bool
+		wantReplicas int32
+		wantWindow   string
+		wantBoundary time.Time
+	}{
+		{
+			name:         "Active override wins over window",
+			now:          time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC),
+			override:     &Override{Replicas: 3, Until: until},
+			wantReplicas: 3,
+			wantWindow:   "Override",
+			wantBoundary: until,
+		},
+		{
+			name:         "Active override wins over holiday",
+			now:          time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC),
+			override:     &Override{Replicas: 3, Until: until},
+			isHoliday:    true,
+			wantReplicas: 3,
+			wantWindow:   "Override",
+			wantBoundary: until,
+		},
+		{
+			name:         "Expired override resumes the schedule",
+			now:          time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC),
+			override:     &Override{Replicas: 3, Until: until},
+			wantReplicas: 10,
+			wantWindow:   "business-hours",
+			wantBoundary: time.Date(2025, 3, 10, 17, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := ComputeEffectiveReplicas(Input{
+				Now:             tt.now,
+				Timezone:        "UTC",
+				Windows:         windows,
+				DefaultReplicas: 1,
+				HolidayMode:     "treat-as-closed",
+				IsHoliday:       tt.isHoliday,
+				Override:        tt.override,
+			})
+			if err != nil {
+				t.Fatalf("ComputeEffectiveReplicas() error = %v", err)
+			}
+
+			if output.EffectiveReplicas != tt.wantReplicas {
+				t.Errorf("EffectiveReplicas = %v, want %v", output.EffectiveReplicas, tt.wantReplicas)
+			}
+			if output.CurrentWindow != tt.wantWindow {
+				t.Errorf("CurrentWindow = %v, want %v", output.CurrentWindow, tt.wantWindow)
+			}
+			if !output.NextBoundary.Equal(tt.wantBoundary) {
+				t.Errorf("NextBoundary = %v, want %v", output.NextBoundary, tt.wantBoundary)
+			}
+		})
+	}
+}
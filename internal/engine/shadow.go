@@ -0,0 +1,65 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"time"
+)
+
+// maxShadowSteps bounds the boundaries evaluated by ShadowedWindows
+const maxShadowSteps = 2000
+
+// ShadowedWindows returns the names of the windows that are active between input.Now and the horizon
+// but never chosen, because an overlapping window wins the conflict resolution whenever they are.
+// Holidays, lead times and ramps are not considered. When the horizon holds more than maxShadowSteps
+// boundaries, no windows are reported, as the ones after the last boundary checked may still be chosen.
+func ShadowedWindows(input Input, horizon time.Duration) ([]string, error) {
+	loc, err := time.LoadLocation(input.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %s: %w", input.Timezone, err)
+	}
+
+	active := make([]string, len(input.Windows))
+	chosen := make([]bool, len(input.Windows))
+	now := input.Now.In(loc)
+	until := now.Add(horizon)
+	for step := 0; !now.After(until); step++ {
+		if step == maxShadowSteps {
+			return nil, nil
+		}
+		matched, next := matchWindows(input, now, loc)
+		for _, window := range matched {
+			active[window.index] = window.displayName()
+		}
+		if window := resolveConflict(matched, input.ConflictResolution); window != nil {
+			chosen[window.index] = true
+		}
+		if !next.After(now) {
+			break
+		}
+		now = next
+	}
+
+	var shadowed []string
+	for i, name := range active {
+		if name != "" && !chosen[i] {
+			shadowed = append(shadowed, name)
+		}
+	}
+	return shadowed, nil
+}
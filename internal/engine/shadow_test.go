@@ -0,0 +1,113 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestShadowedWindows(t *testing.T) {
+	monday := time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)
+	lunch := WindowSpec{Start: "12:00", End: "13:00", Replicas: 8, Name: "lunch"}
+	businessHours := WindowSpec{Start: "09:00", End: "17:00", Replicas: 5, Name: "business-hours"}
+	horizon := 5 * 7 * 24 * time.Hour
+
+	tests := []struct {
+		name  string
+		input Input
+		want  []string
+	}{
+		{
+			name:  "Disjoint windows",
+			input: Input{Windows: []WindowSpec{{Start: "09:00", End: "12:00", Replicas: 5}, {Start: "13:00", End: "17:00", Replicas: 3}}},
+		},
+		{
+			name:  "Overlapping windows both apply",
+			input: Input{Windows: []WindowSpec{lunch, businessHours}},
+			want:  []string{"lunch"},
+		},
+		{
+			name:  "Window listed first applies outside the overlap",
+			input: Input{Windows: []WindowSpec{businessHours, lunch}},
+		},
+		{
+			name:  "Higher priority keeps a nested window",
+			input: Input{ConflictResolution: "HighestPriority", Windows: []WindowSpec{withPriority(lunch, 10), businessHours}},
+		},
+		{
+			name:  "Lower replicas lose under MaxReplicas",
+			input: Input{ConflictResolution: "MaxReplicas", Windows: []WindowSpec{businessHours, withReplicas(lunch, 2)}},
+			want:  []string{"lunch"},
+		},
+		{
+			name: "Weekday window inside an all-week window",
+			input: Input{Windows: []WindowSpec{
+				{Start: "10:00", End: "11:00", Replicas: 2, Days: []string{"Wednesday"}},
+				{Start: "08:00", End: "20:00", Replicas: 4, Name: "daytime"},
+			}},
+			want: []string{"10:00-11:00"},
+		},
+		{
+			name: "Window that never becomes active is not shadowed",
+			input: Input{Windows: []WindowSpec{
+				{Start: "12:00", End: "13:00", Replicas: 8, Name: "expired", StartDate: "2024-01-01", EndDate: "2024-12-31"},
+				businessHours,
+			}},
+		},
+		{
+			name: "Horizon with too many boundaries is skipped",
+			input: Input{Windows: []WindowSpec{
+				lunch,
+				businessHours,
+				{StartCron: "*/5 * * * *", Duration: time.Minute, Replicas: 1, Name: "probe"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.input.Now = monday
+			tt.input.Timezone = "UTC"
+			got, err := ShadowedWindows(tt.input, horizon)
+			if err != nil {
+				t.Fatalf("ShadowedWindows() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ShadowedWindows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShadowedWindowsInvalidTimezone(t *testing.T) {
+	_, err := ShadowedWindows(Input{Now: time.Now(), Timezone: "Mars/Olympus_Mons"}, time.Hour)
+	if err == nil {
+		t.Error("ShadowedWindows() expected error for invalid timezone")
+	}
+}
+
+func withPriority(ws WindowSpec, priority int32) WindowSpec {
+	ws.Priority = priority
+	return ws
+}
+
+func withReplicas(ws WindowSpec, replicas int32) WindowSpec {
+	ws.Replicas = replicas
+	return ws
+}
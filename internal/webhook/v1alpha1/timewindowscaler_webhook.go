@@ -0,0 +1,294 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+	"github.com/roguepikachu/kyklos/internal/engine"
+	"github.com/roguepikachu/kyklos/internal/windowspec"
+)
+
+const (
+	// shadowHorizon is how far ahead windows are checked for being shadowed by overlapping windows,
+	// kept short as the check runs on every admission request
+	shadowHorizon = 5 * 7 * 24 * time.Hour
+	// defaultGracePeriodSeconds matches the CRD default of spec.gracePeriodSeconds
+	defaultGracePeriodSeconds int32 = 300
+)
+
+// log is for logging in this package.
+var timewindowscalerlog = logf.Log.WithName("timewindowscaler-resource")
+
+// SetupTimeWindowScalerWebhookWithManager registers the webhook for TimeWindowScaler in the manager.
+func SetupTimeWindowScalerWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&kyklosv1alpha1.TimeWindowScaler{}).
+		WithValidator(&TimeWindowScalerCustomValidator{}).
+		WithDefaulter(&TimeWindowScalerCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-kyklos-kyklos-io-v1alpha1-timewindowscaler,mutating=true,failurePolicy=fail,sideEffects=None,groups=kyklos.kyklos.io,resources=timewindowscalers,verbs=create;update,versions=v1alpha1,name=mtimewindowscaler-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// TimeWindowScalerCustomDefaulter sets defaults on TimeWindowScalers when they are created or updated,
+// so stored objects spell out the target namespace, grace period, window names and days they use.
+type TimeWindowScalerCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &TimeWindowScalerCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind TimeWindowScaler.
+func (d *TimeWindowScalerCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	tws, ok := obj.(*kyklosv1alpha1.TimeWindowScaler)
+	if !ok {
+		return fmt.Errorf("expected a TimeWindowScaler object but got %T", obj)
+	}
+	timewindowscalerlog.Info("Defaulting for TimeWindowScaler", "name", tws.GetName())
+
+	defaultTimeWindowScaler(tws)
+	return nil
+}
+
+// defaultTimeWindowScaler fills in the target namespace and grace period, expands day ranges and
+// aliases into full day names and names unnamed windows. Days that cannot be expanded are kept
+// for the validator to reject.
+func defaultTimeWindowScaler(tws *kyklosv1alpha1.TimeWindowScaler) {
+	if tws.Spec.Selector == nil && tws.Spec.TargetRef.Namespace == "" {
+		tws.Spec.TargetRef.Namespace = tws.Namespace
+	}
+	if tws.Spec.GracePeriodSeconds == nil {
+		grace := defaultGracePeriodSeconds
+		tws.Spec.GracePeriodSeconds = &grace
+	}
+
+	names := make(map[string]bool, len(tws.Spec.Windows))
+	for _, w := range tws.Spec.Windows {
+		names[w.Name] = true
+	}
+	for i := range tws.Spec.Windows {
+		w := &tws.Spec.Windows[i]
+		if days, err := engine.ExpandDays(w.Days); err == nil {
+			w.Days = days
+		}
+		if w.Name == "" {
+			w.Name = uniqueName(generatedWindowName(*w), names)
+			names[w.Name] = true
+		}
+	}
+}
+
+// generatedWindowName names a window after its schedule, e.g. "0900-1700" or "fri-1800-mon-0600"
+func generatedWindowName(w kyklosv1alpha1.TimeWindow) string {
+	clock := func(value string) string { return strings.ReplaceAll(value, ":", "") }
+	day := func(name string) string { return strings.ToLower(name[:min(3, len(name))]) }
+	switch {
+	case w.StartCron != "":
+		return "cron"
+	case w.StartDay != "" && w.EndDay != "":
+		return fmt.Sprintf("%s-%s-%s-%s", day(w.StartDay), clock(w.Start), day(w.EndDay), clock(w.End))
+	default:
+		return fmt.Sprintf("%s-%s", clock(w.Start), clock(w.End))
+	}
+}
+
+// uniqueName returns name, or name with the first free numeric suffix when it is already taken
+func uniqueName(name string, taken map[string]bool) string {
+	if !taken[name] {
+		return name
+	}
+	for i := 2; ; i++ {
+		if candidate := fmt.Sprintf("%s-%d", name, i); !taken[candidate] {
+			return candidate
+		}
+	}
+}
+
+// +kubebuilder:webhook:path=/validate-kyklos-kyklos-io-v1alpha1-timewindowscaler,mutating=false,failurePolicy=fail,sideEffects=None,groups=kyklos.kyklos.io,resources=timewindowscalers,verbs=create;update,versions=v1alpha1,name=vtimewindowscaler-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// TimeWindowScalerCustomValidator validates TimeWindowScalers when they are created or updated.
+// It rejects what the controller would only report at reconcile time, and warns about windows
+// that are shadowed by overlapping windows and so never apply.
+type TimeWindowScalerCustomValidator struct{}
+
+var _ webhook.CustomValidator = &TimeWindowScalerCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type TimeWindowScaler.
+func (v *TimeWindowScalerCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	tws, ok := obj.(*kyklosv1alpha1.TimeWindowScaler)
+	if !ok {
+		return nil, fmt.Errorf("expected a TimeWindowScaler object but got %T", obj)
+	}
+	timewindowscalerlog.Info("Validation for TimeWindowScaler upon creation", "name", tws.GetName())
+
+	return validateTimeWindowScaler(tws)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type TimeWindowScaler.
+func (v *TimeWindowScalerCustomValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	tws, ok := newObj.(*kyklosv1alpha1.TimeWindowScaler)
+	if !ok {
+		return nil, fmt.Errorf("expected a TimeWindowScaler object for the newObj but got %T", newObj)
+	}
+	timewindowscalerlog.Info("Validation for TimeWindowScaler upon update", "name", tws.GetName())
+
+	return validateTimeWindowScaler(tws)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type TimeWindowScaler.
+func (v *TimeWindowScalerCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateTimeWindowScaler validates the timezone, windows and target of a TimeWindowScaler.
+// Windows referenced through scheduleRef are validated by the controller, as they live in another object.
+func validateTimeWindowScaler(tws *kyklosv1alpha1.TimeWindowScaler) (admission.Warnings, error) {
+	specPath := field.NewPath("spec")
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+
+	if tws.Spec.ScheduleRef == nil {
+		if _, err := time.LoadLocation(tws.Spec.Timezone); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("timezone"), tws.Spec.Timezone, "unknown IANA timezone"))
+		}
+	}
+
+	windows, err := windowspec.Convert(tws.Spec.Windows, tws.Spec.OneOffWindows)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("windows"), len(tws.Spec.Windows), err.Error()))
+	}
+	allErrs = append(allErrs, validateWindowBounds(tws.Spec.Windows, specPath.Child("windows"))...)
+
+	if tws.Spec.Selector == nil {
+		targetErrs, targetWarnings := validateTargetRef(tws, specPath.Child("targetRef"))
+		allErrs = append(allErrs, targetErrs...)
+		warnings = append(warnings, targetWarnings...)
+	}
+
+	if len(allErrs) > 0 {
+		return warnings, apierrors.NewInvalid(kyklosv1alpha1.GroupVersion.WithKind("TimeWindowScaler").GroupKind(), tws.Name, allErrs)
+	}
+
+	if tws.Spec.ScheduleRef == nil {
+		shadowed, err := engine.ShadowedWindows(engine.Input{
+			Now:                time.Now(),
+			Timezone:           tws.Spec.Timezone,
+			DSTPolicy:          tws.Spec.DSTPolicy,
+			Windows:            windows,
+			ConflictResolution: tws.Spec.ConflictResolution,
+		}, shadowHorizon)
+		if err != nil {
+			return warnings, err
+		}
+		for _, name := range shadowed {
+			warnings = append(warnings, fmt.Sprintf(
+				"window '%s' never applies: it is shadowed by overlapping windows under the %s conflict resolution",
+				name, conflictResolutionOf(tws)))
+		}
+	}
+	return warnings, nil
+}
+
+// validateWindowBounds rejects windows that start and end at the same time of day on the same day.
+// The controller treats them as 24-hour windows, so objects stored before this check keep working.
+func validateWindowBounds(windows []kyklosv1alpha1.TimeWindow, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, w := range windows {
+		if w.StartCron != "" || !strings.EqualFold(w.StartDay, w.EndDay) {
+			continue
+		}
+		start, errStart := time.Parse("15:04", w.Start)
+		end, errEnd := time.Parse("15:04", w.End)
+		if errStart == nil && errEnd == nil && start.Equal(end) {
+			allErrs = append(allErrs, field.Invalid(path.Index(i).Child("end"), w.End,
+				fmt.Sprintf("window '%s' has identical start and end %s", w.Name, w.Start)))
+		}
+	}
+	return allErrs
+}
+
+// validateTargetRef rejects targets the controller cannot scale, and warns about replicas an HPA target raises
+func validateTargetRef(tws *kyklosv1alpha1.TimeWindowScaler, path *field.Path) (field.ErrorList, admission.Warnings) {
+	ref := &tws.Spec.TargetRef
+	var allErrs field.ErrorList
+
+	gv := schema.GroupVersion{Group: appsv1.GroupName, Version: "v1"}
+	if ref.APIVersion != "" {
+		var err error
+		if gv, err = schema.ParseGroupVersion(ref.APIVersion); err != nil {
+			return append(allErrs, field.Invalid(path.Child("apiVersion"), ref.APIVersion, err.Error())), nil
+		}
+	}
+	kind := ref.Kind
+	if kind == "" {
+		kind = kyklosv1alpha1.TargetKindDeployment
+	}
+
+	switch {
+	case gv.Group == kyklosv1alpha1.GroupVersion.Group:
+		allErrs = append(allErrs, field.Invalid(path.Child("apiVersion"), ref.APIVersion, "Kyklos resources cannot be scaled"))
+	case kind == kyklosv1alpha1.TargetKindHorizontalPodAutoscaler && gv.Group != autoscalingv2.GroupName:
+		allErrs = append(allErrs, field.Invalid(path.Child("apiVersion"), ref.APIVersion,
+			"HorizontalPodAutoscaler targets must use the autoscaling group"))
+	case gv.Group == appsv1.GroupName && kind != kyklosv1alpha1.TargetKindDeployment &&
+		kind != kyklosv1alpha1.TargetKindStatefulSet && kind != "ReplicaSet":
+		allErrs = append(allErrs, field.Invalid(path.Child("kind"), kind, "apps kind has no scale subresource"))
+	}
+	if len(allErrs) > 0 {
+		return allErrs, nil
+	}
+
+	if gv.Group != autoscalingv2.GroupName || kind != kyklosv1alpha1.TargetKindHorizontalPodAutoscaler {
+		return nil, nil
+	}
+	var warnings admission.Warnings
+	if tws.Spec.DefaultReplicas == 0 {
+		warnings = append(warnings, "defaultReplicas 0 is raised to 1 for a HorizontalPodAutoscaler target")
+	}
+	for _, w := range tws.Spec.Windows {
+		if w.Replicas == 0 {
+			warnings = append(warnings, fmt.Sprintf("window '%s' replicas 0 is raised to 1 for a HorizontalPodAutoscaler target", w.Name))
+		}
+	}
+	for _, w := range tws.Spec.OneOffWindows {
+		if w.Replicas == 0 {
+			warnings = append(warnings, fmt.Sprintf("one-off window '%s' replicas 0 is raised to 1 for a HorizontalPodAutoscaler target", w.Name))
+		}
+	}
+	return nil, warnings
+}
+
+// conflictResolutionOf returns the conflict resolution of a TimeWindowScaler, defaulting to LastWins
+func conflictResolutionOf(tws *kyklosv1alpha1.TimeWindowScaler) string {
+	if tws.Spec.ConflictResolution == "" {
+		return kyklosv1alpha1.ConflictResolutionLastWins
+	}
+	return tws.Spec.ConflictResolution
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+)
+
+var _ = Describe("TimeWindowScaler Webhook", func() {
+	var (
+		obj       *kyklosv1alpha1.TimeWindowScaler
+		validator TimeWindowScalerCustomValidator
+		defaulter TimeWindowScalerCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &kyklosv1alpha1.TimeWindowScaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-tws", Namespace: "default"},
+			Spec: kyklosv1alpha1.TimeWindowScalerSpec{
+				TargetRef: kyklosv1alpha1.TargetRef{Name: "web"},
+				Timezone:  "Europe/Berlin",
+				Windows: []kyklosv1alpha1.TimeWindow{
+					{Name: "business-hours", Start: "09:00", End: "17:00", Replicas: 5,
+						Days: []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}},
+				},
+				DefaultReplicas: 1,
+			},
+		}
+	})
+
+	Context("When creating TimeWindowScaler under Defaulting Webhook", func() {
+		It("Should fill in the target namespace, grace period and window names", func() {
+			obj.Spec.Windows = append(obj.Spec.Windows,
+				kyklosv1alpha1.TimeWindow{Start: "18:00", End: "06:00", Replicas: 2},
+				kyklosv1alpha1.TimeWindow{Start: "18:00", End: "06:00", Replicas: 3, Days: []string{"Sat"}},
+				kyklosv1alpha1.TimeWindow{Start: "18:00", End: "06:00", Replicas: 4, StartDay: "Friday", EndDay: "Monday"},
+			)
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+
+			Expect(obj.Spec.TargetRef.Namespace).To(Equal("default"))
+			Expect(obj.Spec.GracePeriodSeconds).NotTo(BeNil())
+			Expect(*obj.Spec.GracePeriodSeconds).To(Equal(int32(300)))
+			Expect(obj.Spec.Windows[0].Name).To(Equal("business-hours"))
+			Expect(obj.Spec.Windows[1].Name).To(Equal("1800-0600"))
+			Expect(obj.Spec.Windows[2].Name).To(Equal("1800-0600-2"))
+			Expect(obj.Spec.Windows[3].Name).To(Equal("fri-1800-mon-0600"))
+		})
+
+		It("Should keep an explicit target namespace and grace period", func() {
+			grace := int32(0)
+			obj.Spec.TargetRef.Namespace = "apps"
+			obj.Spec.GracePeriodSeconds = &grace
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+
+			Expect(obj.Spec.TargetRef.Namespace).To(Equal("apps"))
+			Expect(*obj.Spec.GracePeriodSeconds).To(Equal(int32(0)))
+		})
+
+		It("Should not set a target namespace for a selector", func() {
+			obj.Spec.TargetRef = kyklosv1alpha1.TargetRef{}
+			obj.Spec.Selector = &kyklosv1alpha1.TargetSelector{}
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+
+			Expect(obj.Spec.TargetRef.Namespace).To(BeEmpty())
+		})
+
+		It("Should expand day ranges and aliases into full day names", func() {
+			obj.Spec.Windows[0].Days = []string{"Mon-Wed", "thu", "Friday"}
+			obj.Spec.Windows = append(obj.Spec.Windows,
+				kyklosv1alpha1.TimeWindow{Name: "weekend", Start: "10:00", End: "14:00", Replicas: 2, Days: []string{"weekends"}})
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+
+			Expect(obj.Spec.Windows[0].Days).To(Equal([]string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}))
+			Expect(obj.Spec.Windows[1].Days).To(Equal([]string{"Saturday", "Sunday"}))
+		})
+
+		It("Should leave invalid days for the validator to reject", func() {
+			obj.Spec.Windows[0].Days = []string{"Munday-Fri"}
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+			Expect(obj.Spec.Windows[0].Days).To(Equal([]string{"Munday-Fri"}))
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(MatchError(ContainSubstring("invalid day range 'Munday-Fri'")))
+		})
+	})
+
+	Context("When creating or updating TimeWindowScaler under Validating Webhook", func() {
+		It("Should admit a valid TimeWindowScaler without warnings", func() {
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should deny an unknown timezone", func() {
+			obj.Spec.Timezone = "Europe/Atlantis"
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.timezone")))
+		})
+
+		It("Should not load the timezone of a referenced schedule", func() {
+			obj.Spec.Timezone = ""
+			obj.Spec.Windows = nil
+			obj.Spec.ScheduleRef = &kyklosv1alpha1.ScheduleRef{Name: "office-hours"}
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny a misspelled day name", func() {
+			obj.Spec.Windows[0].Days = []string{"Munday"}
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(MatchError(ContainSubstring("invalid day 'Munday'")))
+		})
+
+		It("Should deny a window whose start equals its end", func() {
+			obj.Spec.Windows[0].End = "09:00"
+			_, err := validator.ValidateUpdate(context.Background(), obj.DeepCopy(), obj)
+			Expect(err).To(MatchError(ContainSubstring("identical start and end")))
+		})
+
+		It("Should deny a window whose start equals its end written differently", func() {
+			obj.Spec.Windows[0].Start = "9:00"
+			obj.Spec.Windows[0].End = "09:00"
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(MatchError(ContainSubstring("identical start and end")))
+		})
+
+		It("Should admit a week-long multi-day window with identical times on different days", func() {
+			obj.Spec.Windows[0].Days = nil
+			obj.Spec.Windows[0].StartDay = "Friday"
+			obj.Spec.Windows[0].EndDay = "Monday"
+			obj.Spec.Windows[0].End = "09:00"
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should warn about a window shadowed by an overlapping window", func() {
+			obj.Spec.Windows = append([]kyklosv1alpha1.TimeWindow{
+				{Name: "lunch", Start: "12:00", End: "13:00", Replicas: 8},
+			}, obj.Spec.Windows...)
+			obj.Spec.Windows[1].Days = nil
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring("window 'lunch' never applies")))
+		})
+
+		It("Should not warn when the conflict resolution lets the nested window apply", func() {
+			obj.Spec.ConflictResolution = kyklosv1alpha1.ConflictResolutionMaxReplicas
+			obj.Spec.Windows = append([]kyklosv1alpha1.TimeWindow{
+				{Name: "lunch", Start: "12:00", End: "13:00", Replicas: 8},
+			}, obj.Spec.Windows...)
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should deny a target the controller cannot scale", func() {
+			obj.Spec.TargetRef = kyklosv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "agent"}
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.targetRef.kind")))
+
+			obj.Spec.TargetRef = kyklosv1alpha1.TargetRef{APIVersion: "kyklos.kyklos.io/v1alpha1", Kind: "TimeWindowScaler", Name: "other"}
+			_, err = validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(MatchError(ContainSubstring("Kyklos resources cannot be scaled")))
+
+			obj.Spec.TargetRef = kyklosv1alpha1.TargetRef{APIVersion: "apps/v1", Kind: "HorizontalPodAutoscaler", Name: "web"}
+			_, err = validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(MatchError(ContainSubstring("must use the autoscaling group")))
+		})
+
+		It("Should admit a custom scalable kind", func() {
+			obj.Spec.TargetRef = kyklosv1alpha1.TargetRef{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Name: "web"}
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should warn that an HPA target cannot scale to zero", func() {
+			obj.Spec.TargetRef = kyklosv1alpha1.TargetRef{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler", Name: "web"}
+			obj.Spec.DefaultReplicas = 0
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring("defaultReplicas 0 is raised to 1")))
+		})
+	})
+})
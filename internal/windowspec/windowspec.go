@@ -0,0 +1,310 @@
+/*
+Copyright 2025 roguepikachu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package windowspec validates the windows of the Kyklos API and converts them to engine window specs,
+// for both the controllers and the admission webhooks.
+package windowspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kyklosv1alpha1 "github.com/roguepikachu/kyklos/api/v1alpha1"
+	"github.com/roguepikachu/kyklos/internal/engine"
+)
+
+// Convert validates recurring and one-off windows and converts them to engine window specs.
+// One-off windows follow the recurring ones so they take precedence while active.
+func Convert(windows []kyklosv1alpha1.TimeWindow, oneOffWindows []kyklosv1alpha1.OneOffWindow) ([]engine.WindowSpec, error) {
+	recurring, err := ConvertRecurring(windows)
+	if err != nil {
+		return nil, err
+	}
+	oneOff, err := convertOneOffWindows(oneOffWindows)
+	if err != nil {
+		return nil, err
+	}
+	return append(recurring, oneOff...), nil
+}
+
+// ConvertRecurring validates API windows and converts them to engine window specs
+func ConvertRecurring(apiWindows []kyklosv1alpha1.TimeWindow) ([]engine.WindowSpec, error) {
+	windows := make([]engine.WindowSpec, len(apiWindows))
+	for i, w := range apiWindows {
+		var duration time.Duration
+		days := w.Days
+		if w.StartCron != "" {
+			// Validate cron expression and duration
+			if _, err := engine.ParseCron(w.StartCron); err != nil {
+				return nil, fmt.Errorf("window '%s' has invalid startCron: %w", w.Name, err)
+			}
+			if w.Duration == nil || w.Duration.Duration <= 0 {
+				return nil, fmt.Errorf("window '%s' requires a positive duration with startCron", w.Name)
+			}
+			duration = w.Duration.Duration
+		} else {
+			// Validate time format
+			if err := validateTimeFormat(w.Start); err != nil {
+				return nil, fmt.Errorf("window '%s' has invalid start time '%s': %w", w.Name, w.Start, err)
+			}
+			if err := validateTimeFormat(w.End); err != nil {
+				return nil, fmt.Errorf("window '%s' has invalid end time '%s': %w", w.Name, w.End, err)
+			}
+			if err := validateDateRestrictions(w); err != nil {
+				return nil, fmt.Errorf("window '%s' %w", w.Name, err)
+			}
+			// Day ranges and aliases are normally expanded on admission
+			expanded, err := engine.ExpandDays(w.Days)
+			if err != nil {
+				return nil, fmt.Errorf("window '%s' has %w", w.Name, err)
+			}
+			days = expanded
+		}
+
+		// Validate replicas
+		if w.Replicas < 0 {
+			return nil, fmt.Errorf("window '%s' has invalid replicas %d: must be >= 0", w.Name, w.Replicas)
+		}
+
+		// Validate HPA bounds
+		if w.MaxReplicas != nil && *w.MaxReplicas < w.Replicas {
+			return nil, fmt.Errorf("window '%s' has maxReplicas %d below replicas %d", w.Name, *w.MaxReplicas, w.Replicas)
+		}
+
+		// Validate ramp
+		ramp, stepSize, err := convertRamp(w.RampDuration, w.StepSize)
+		if err != nil {
+			return nil, fmt.Errorf("window '%s' %w", w.Name, err)
+		}
+		lead, err := ConvertLeadTime(w.LeadTime)
+		if err != nil {
+			return nil, fmt.Errorf("window '%s' %w", w.Name, err)
+		}
+
+		windows[i] = engine.WindowSpec{
+			Start:               w.Start,
+			End:                 w.End,
+			Replicas:            w.Replicas,
+			MaxReplicas:         w.MaxReplicas,
+			Name:                w.Name,
+			Priority:            w.Priority,
+			Days:                days,
+			StartDay:            w.StartDay,
+			EndDay:              w.EndDay,
+			Months:              w.Months,
+			StartDate:           w.StartDate,
+			EndDate:             w.EndDate,
+			DaysOfMonth:         daysOfMonth(w.DaysOfMonth),
+			BusinessDaysOfMonth: daysOfMonth(w.BusinessDaysOfMonth),
+			WeekdayOccurrence:   weekdayOccurrence(w.WeekdayOccurrence),
+			StartCron:           w.StartCron,
+			Duration:            duration,
+			RampDuration:        ramp,
+			StepSize:            stepSize,
+			LeadTime:            lead,
+		}
+	}
+	return windows, nil
+}
+
+// convertOneOffWindows validates API one-off windows and converts them to engine windows
+func convertOneOffWindows(apiWindows []kyklosv1alpha1.OneOffWindow) ([]engine.WindowSpec, error) {
+	windows := make([]engine.WindowSpec, len(apiWindows))
+	for i, w := range apiWindows {
+		// Validate datetimes; the timezone is applied by the engine
+		start, err := time.Parse(engine.DateTimeLayout, w.Start)
+		if err != nil {
+			return nil, fmt.Errorf("one-off window '%s' has invalid start '%s': %w", w.Name, w.Start, err)
+		}
+		end, err := time.Parse(engine.DateTimeLayout, w.End)
+		if err != nil {
+			return nil, fmt.Errorf("one-off window '%s' has invalid end '%s': %w", w.Name, w.End, err)
+		}
+		if !end.After(start) {
+			return nil, fmt.Errorf("one-off window '%s' must end after it starts", w.Name)
+		}
+
+		// Validate replicas and HPA bounds
+		if w.Replicas < 0 {
+			return nil, fmt.Errorf("one-off window '%s' has invalid replicas %d: must be >= 0", w.Name, w.Replicas)
+		}
+		if w.MaxReplicas != nil && *w.MaxReplicas < w.Replicas {
+			return nil, fmt.Errorf("one-off window '%s' has maxReplicas %d below replicas %d", w.Name, *w.MaxReplicas, w.Replicas)
+		}
+		ramp, stepSize, err := convertRamp(w.RampDuration, w.StepSize)
+		if err != nil {
+			return nil, fmt.Errorf("one-off window '%s' %w", w.Name, err)
+		}
+		lead, err := ConvertLeadTime(w.LeadTime)
+		if err != nil {
+			return nil, fmt.Errorf("one-off window '%s' %w", w.Name, err)
+		}
+
+		windows[i] = engine.WindowSpec{
+			StartAt:      w.Start,
+			EndAt:        w.End,
+			Replicas:     w.Replicas,
+			MaxReplicas:  w.MaxReplicas,
+			Name:         w.Name,
+			Priority:     w.Priority,
+			RampDuration: ramp,
+			StepSize:     stepSize,
+			LeadTime:     lead,
+		}
+	}
+	return windows, nil
+}
+
+// convertRamp validates the ramp of a window and returns its duration and step size
+func convertRamp(rampDuration *metav1.Duration, stepSize *int32) (time.Duration, int32, error) {
+	if rampDuration == nil {
+		if stepSize != nil {
+			return 0, 0, fmt.Errorf("sets stepSize without rampDuration")
+		}
+		return 0, 0, nil
+	}
+	if rampDuration.Duration <= 0 {
+		return 0, 0, fmt.Errorf("requires a positive rampDuration")
+	}
+	if stepSize == nil {
+		return rampDuration.Duration, 1, nil
+	}
+	if *stepSize < 1 {
+		return 0, 0, fmt.Errorf("has invalid stepSize %d: must be >= 1", *stepSize)
+	}
+	return rampDuration.Duration, *stepSize, nil
+}
+
+// ConvertLeadTime validates a lead time; nil stays nil so a window inherits spec.leadTime
+func ConvertLeadTime(leadTime *metav1.Duration) (*time.Duration, error) {
+	if leadTime == nil {
+		return nil, nil
+	}
+	if leadTime.Duration < 0 {
+		return nil, fmt.Errorf("has negative leadTime %s", leadTime.Duration)
+	}
+	return &leadTime.Duration, nil
+}
+
+// validateDateRestrictions validates the multi-day start and end days, month names,
+// YYYY-MM-DD date range and day-of-month recurrence rules of a window
+func validateDateRestrictions(w kyklosv1alpha1.TimeWindow) error {
+	if (w.StartDay == "") != (w.EndDay == "") {
+		return fmt.Errorf("must set startDay and endDay together")
+	}
+	if w.StartDay != "" {
+		if !isWeekdayName(w.StartDay) {
+			return fmt.Errorf("has invalid startDay '%s'", w.StartDay)
+		}
+		if !isWeekdayName(w.EndDay) {
+			return fmt.Errorf("has invalid endDay '%s'", w.EndDay)
+		}
+		if len(w.Days) > 0 {
+			return fmt.Errorf("cannot combine days with startDay")
+		}
+	}
+	for _, month := range w.Months {
+		if !isMonthName(month) {
+			return fmt.Errorf("has invalid month '%s'", month)
+		}
+	}
+	var startDate, endDate time.Time
+	var err error
+	if w.StartDate != "" {
+		if startDate, err = time.Parse(engine.DateLayout, w.StartDate); err != nil {
+			return fmt.Errorf("has invalid startDate '%s': %w", w.StartDate, err)
+		}
+	}
+	if w.EndDate != "" {
+		if endDate, err = time.Parse(engine.DateLayout, w.EndDate); err != nil {
+			return fmt.Errorf("has invalid endDate '%s': %w", w.EndDate, err)
+		}
+	}
+	if !startDate.IsZero() && !endDate.IsZero() && endDate.Before(startDate) {
+		return fmt.Errorf("has endDate %s before startDate %s", w.EndDate, w.StartDate)
+	}
+	for _, day := range w.DaysOfMonth {
+		if day == 0 || day < -31 || day > 31 {
+			return fmt.Errorf("has invalid day of month %d: must be 1 to 31 or -31 to -1", day)
+		}
+	}
+	for _, day := range w.BusinessDaysOfMonth {
+		if day == 0 || day < -23 || day > 23 {
+			return fmt.Errorf("has invalid business day of month %d: must be 1 to 23 or -23 to -1", day)
+		}
+	}
+	if occurrence := w.WeekdayOccurrence; occurrence != nil {
+		if !isWeekdayName(occurrence.Weekday) {
+			return fmt.Errorf("has invalid weekdayOccurrence weekday '%s'", occurrence.Weekday)
+		}
+		if occurrence.Nth == 0 || occurrence.Nth < -5 || occurrence.Nth > 5 {
+			return fmt.Errorf("has invalid weekdayOccurrence nth %d: must be 1 to 5 or -5 to -1", occurrence.Nth)
+		}
+	}
+	return nil
+}
+
+// isWeekdayName reports whether name is a full day name, ignoring case
+func isWeekdayName(name string) bool {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(name, day.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMonthName reports whether name is a full month name, ignoring case
+func isMonthName(name string) bool {
+	for month := time.January; month <= time.December; month++ {
+		if strings.EqualFold(name, month.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// daysOfMonth converts the API days or business days of the month for the engine
+func daysOfMonth(days []int32) []int {
+	if len(days) == 0 {
+		return nil
+	}
+	converted := make([]int, len(days))
+	for i, day := range days {
+		converted[i] = int(day)
+	}
+	return converted
+}
+
+// weekdayOccurrence converts an API weekday occurrence for the engine
+func weekdayOccurrence(occurrence *kyklosv1alpha1.WeekdayOccurrence) *engine.WeekdayOccurrence {
+	if occurrence == nil {
+		return nil
+	}
+	return &engine.WeekdayOccurrence{Weekday: occurrence.Weekday, Nth: int(occurrence.Nth)}
+}
+
+// validateTimeFormat validates HH:MM time format
+func validateTimeFormat(timeStr string) error {
+	_, err := time.Parse("15:04", timeStr)
+	if err != nil {
+		return fmt.Errorf("invalid time format (expected HH:MM): %w", err)
+	}
+	return nil
+}